@@ -21,7 +21,7 @@ type APIApp struct {
 // APIAppDetail represent api app detail
 type APIAppDetail struct {
 	ClientID             string             `json:"client_id"`
-	CreatedAt            int64              `json:"created_at"`
+	CreatedAt            UnixTime           `json:"created_at"`
 	Name                 string             `json:"name"`
 	Domain               string             `json:"domain"`
 	CallbackURL          string             `json:"callback_url"`
@@ -0,0 +1,158 @@
+package hellosign
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// BulkSendJobAPI is a service to bulk send job API
+type BulkSendJobAPI service
+
+// BulkSendJob represent bulk send job response
+type BulkSendJob struct {
+	BulkSendJob       BulkSendJobDetail        `json:"bulk_send_job"`
+	ListInfo          ListInfo                 `json:"list_info"`
+	SignatureRequests []SignatureRequestDetail `json:"signature_requests"`
+	Warnings          []Warnings               `json:"warnings,omitempty"`
+}
+
+// BulkSendJobDetail represent detail of a bulk send job
+type BulkSendJobDetail struct {
+	BulkSendJobID string   `json:"bulk_send_job_id"`
+	Total         int      `json:"total"`
+	IsCreator     bool     `json:"is_creator"`
+	CreatedAt     UnixTime `json:"created_at"`
+}
+
+// BulkSendJobList represent list of bulk send jobs response
+type BulkSendJobList struct {
+	ListInfo     ListInfo            `json:"list_info"`
+	BulkSendJobs []BulkSendJobDetail `json:"bulk_send_jobs"`
+	Warnings     []Warnings          `json:"warnings,omitempty"`
+}
+
+// CheckWarnings check if there are warning messages
+func (b BulkSendJob) CheckWarnings() bool {
+	return len(b.Warnings) > 0
+}
+
+const (
+	// subURLBulkSendJob is sub url path for bulk send job
+	subURLBulkSendJob = "/bulk_send_job"
+)
+
+var (
+	// subURLBulkSendJobList is sub url path for list bulk send jobs
+	subURLBulkSendJobList = subURLBulkSendJob + "/list"
+)
+
+// Get will return a bulk send job and the first page of its signature requests
+// Ref: https://app.hellosign.com/api/reference#get_bulk_send_job
+func (b *BulkSendJobAPI) Get(ctx context.Context, jobID string) (BulkSendJob, error) {
+	return b.GetPage(ctx, jobID, ListInfoQueryParam{})
+}
+
+// GetPage will return a bulk send job and a page of its signature requests
+// Ref: https://app.hellosign.com/api/reference#get_bulk_send_job
+func (b *BulkSendJobAPI) GetPage(ctx context.Context, jobID string, p ListInfoQueryParam) (BulkSendJob, error) {
+	req, err := b.client.prepareRequest(
+		ctx,
+		requestParam{
+			path:   b.client.BaseURL + subURLBulkSendJob + "/" + jobID,
+			method: http.MethodGet,
+		})
+	if err != nil {
+		return BulkSendJob{}, err
+	}
+
+	q := req.URL.Query()
+	p.addQuery(q)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := b.client.executeRequest(req)
+	if err != nil {
+		return BulkSendJob{}, err
+	}
+	defer resp.Body.Close()
+
+	bulkSendJob := BulkSendJob{}
+	err = json.NewDecoder(resp.Body).Decode(&bulkSendJob)
+	if err != nil {
+		return BulkSendJob{}, err
+	}
+
+	return bulkSendJob, nil
+}
+
+// List will return a list of bulk send jobs which you can access
+// Ref: https://app.hellosign.com/api/reference#list_bulk_send_jobs
+func (b *BulkSendJobAPI) List(ctx context.Context, p ListInfoQueryParam) (BulkSendJobList, error) {
+	req, err := b.client.prepareRequest(
+		ctx,
+		requestParam{
+			path:   b.client.BaseURL + subURLBulkSendJobList,
+			method: http.MethodGet,
+		})
+	if err != nil {
+		return BulkSendJobList{}, err
+	}
+
+	q := req.URL.Query()
+	p.addQuery(q)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := b.client.executeRequest(req)
+	if err != nil {
+		return BulkSendJobList{}, err
+	}
+	defer resp.Body.Close()
+
+	bulkSendJobList := BulkSendJobList{}
+	err = json.NewDecoder(resp.Body).Decode(&bulkSendJobList)
+	if err != nil {
+		return BulkSendJobList{}, err
+	}
+
+	return bulkSendJobList, nil
+}
+
+// ListAll will return a pager which iterates bulk send jobs across all pages
+// starting from p.Page
+func (b *BulkSendJobAPI) ListAll(p ListInfoQueryParam) *BulkSendJobPager {
+	fetch := func(ctx context.Context, page ListInfoQueryParam) ([]interface{}, ListInfo, error) {
+		list, err := b.List(ctx, page)
+		if err != nil {
+			return nil, ListInfo{}, err
+		}
+
+		items := make([]interface{}, 0, len(list.BulkSendJobs))
+		for _, bulkSendJob := range list.BulkSendJobs {
+			items = append(items, bulkSendJob)
+		}
+
+		return items, list.ListInfo, nil
+	}
+
+	return &BulkSendJobPager{NewPager(fetch, p)}
+}
+
+// SignatureRequests will return a pager which iterates signature requests
+// of a bulk send job across all pages starting from p.Page
+func (b *BulkSendJobAPI) SignatureRequests(jobID string, p ListInfoQueryParam) *SignatureRequestPager {
+	fetch := func(ctx context.Context, page ListInfoQueryParam) ([]interface{}, ListInfo, error) {
+		bulkSendJob, err := b.GetPage(ctx, jobID, page)
+		if err != nil {
+			return nil, ListInfo{}, err
+		}
+
+		items := make([]interface{}, 0, len(bulkSendJob.SignatureRequests))
+		for _, signatureRequest := range bulkSendJob.SignatureRequests {
+			items = append(items, signatureRequest)
+		}
+
+		return items, bulkSendJob.ListInfo, nil
+	}
+
+	return &SignatureRequestPager{NewPager(fetch, p)}
+}
@@ -0,0 +1,129 @@
+package hellosign_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+	"github.com/milhamhidayat/go-hellosign-sdk/testdata"
+)
+
+func TestBulkSendJob_Get(t *testing.T) {
+	is := is.New(t)
+
+	bulkSendJobJSON := testdata.GetGolden(t, "bulk-send-job")
+	bulkSendJob := hellosign.BulkSendJob{}
+	err := json.Unmarshal(bulkSendJobJSON, &bulkSendJob)
+	is.NoErr(err)
+	is.Equal(2, len(bulkSendJob.SignatureRequests))
+
+	errNotFoundJSON := testdata.GetGolden(t, "signature-request-err-not-found")
+
+	tests := map[string]struct {
+		bulkSendJobID       string
+		bulkSendJobResponse http.Response
+		expectedBulkSendJob hellosign.BulkSendJob
+		expectedError       error
+	}{
+		"success": {
+			bulkSendJobID: "6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174",
+			bulkSendJobResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(bulkSendJobJSON)),
+				Header:     make(http.Header),
+			},
+			expectedBulkSendJob: bulkSendJob,
+			expectedError:       nil,
+		},
+		"not found": {
+			bulkSendJobID: "6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174",
+			bulkSendJobResponse: http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(bytes.NewReader(errNotFoundJSON)),
+				Header:     make(http.Header),
+			},
+			expectedBulkSendJob: hellosign.BulkSendJob{},
+			expectedError:       errors.New("not_found: Not found"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal("/v3/bulk_send_job/"+test.bulkSendJobID, req.URL.Path)
+				return &test.bulkSendJobResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.BulkSendJobAPI.Get(context.TODO(), test.bulkSendJobID)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedBulkSendJob, resp)
+		})
+	}
+}
+
+func TestBulkSendJob_List(t *testing.T) {
+	is := is.New(t)
+
+	bulkSendJobListJSON := testdata.GetGolden(t, "bulk-send-job-list")
+	bulkSendJobList := hellosign.BulkSendJobList{}
+	err := json.Unmarshal(bulkSendJobListJSON, &bulkSendJobList)
+	is.NoErr(err)
+
+	tests := map[string]struct {
+		param                   hellosign.ListInfoQueryParam
+		expectedQuery           string
+		bulkSendJobListResponse http.Response
+		expectedBulkSendJobList hellosign.BulkSendJobList
+		expectedError           error
+	}{
+		"success": {
+			param: hellosign.ListInfoQueryParam{
+				Page:     1,
+				PageSize: 20,
+			},
+			expectedQuery: "page=1&page_size=20",
+			bulkSendJobListResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(bulkSendJobListJSON)),
+				Header:     make(http.Header),
+			},
+			expectedBulkSendJobList: bulkSendJobList,
+			expectedError:           nil,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal("/v3/bulk_send_job/list", req.URL.Path)
+				is.Equal(test.expectedQuery, req.URL.RawQuery)
+				return &test.bulkSendJobListResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.BulkSendJobAPI.List(context.TODO(), test.param)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedBulkSendJobList, resp)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package hellosign
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MergeFieldDetail is a merge field declared by a template, filled with a custom field
+type MergeFieldDetail struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// CustomFieldsFromStruct builds custom fields from fields of v tagged with `hellosign:"name"`.
+// Tag options are required and editor=Role, ex: `hellosign:"Cost,required,editor=Client"`.
+// bool fields become checkbox custom fields, string and number fields become text custom fields.
+// Nil pointer fields are skipped. It returns ValidationErrors for empty required fields.
+func CustomFieldsFromStruct(v interface{}) ([]CustomFieldsDetail, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, errors.New("hellosign: custom fields requires a struct")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, errors.New("hellosign: custom fields requires a struct")
+	}
+
+	errs := ValidationErrors{}
+	fields := []CustomFieldsDetail{}
+	for _, field := range structTagFields(rv.Type()) {
+		path := "custom_fields[" + field.name + "]"
+
+		value := rv.Field(field.index)
+		if value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				if field.required {
+					errs.add(path, "is required")
+				}
+				continue
+			}
+			value = value.Elem()
+		}
+
+		customField := CustomFieldsDetail{
+			Name:      field.name,
+			FieldType: FieldText,
+			Required:  field.required,
+			Editor:    field.editor,
+		}
+
+		switch value.Kind() {
+		case reflect.Bool:
+			customField.FieldType = FieldCheckBox
+			customField.Value = value.Bool()
+		case reflect.String:
+			customField.Value = value.String()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			customField.Value = strconv.FormatInt(value.Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			customField.Value = strconv.FormatUint(value.Uint(), 10)
+		case reflect.Float32, reflect.Float64:
+			customField.Value = strconv.FormatFloat(value.Float(), 'f', -1, value.Type().Bits())
+		default:
+			stringer, ok := value.Interface().(fmt.Stringer)
+			if !ok {
+				errs.add(path, "unsupported field type %s", value.Type())
+				continue
+			}
+			customField.Value = stringer.String()
+		}
+
+		if field.required && customField.Value == "" {
+			errs.add(path, "is required")
+			continue
+		}
+
+		fields = append(fields, customField)
+	}
+
+	err := errs.err()
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// ValidateCustomFields check that each custom field fills a merge field declared by the template.
+// Names are case sensitive, a close match is suggested for a misspelled name.
+// It returns ValidationErrors which contains every unknown or mismatched custom field.
+func ValidateCustomFields(fields []CustomFieldsDetail, mergeFields []MergeFieldDetail) error {
+	mergeFieldsByName := map[string]MergeFieldDetail{}
+	mergeFieldsByKey := map[string]string{}
+	for _, mergeField := range mergeFields {
+		mergeFieldsByName[mergeField.Name] = mergeField
+		mergeFieldsByKey[mergeFieldKey(mergeField.Name)] = mergeField.Name
+	}
+
+	errs := ValidationErrors{}
+	for _, field := range fields {
+		path := "custom_fields[" + field.Name + "]"
+
+		mergeField, ok := mergeFieldsByName[field.Name]
+		if !ok {
+			suggestion, ok := mergeFieldsByKey[mergeFieldKey(field.Name)]
+			if ok {
+				errs.add(path, "unknown merge field, did you mean %q?", suggestion)
+				continue
+			}
+			errs.add(path, "unknown merge field, template declares %s", strings.Join(mergeFieldNames(mergeFields), ", "))
+			continue
+		}
+
+		_, isBool := field.Value.(bool)
+		switch {
+		case mergeField.Type == FieldCheckBox && !isBool:
+			errs.add(path, "checkbox merge field requires a bool value")
+		case mergeField.Type == FieldText && isBool:
+			errs.add(path, "text merge field requires a text value")
+		}
+	}
+
+	return errs.err()
+}
+
+// mergeFieldKey normalizes a merge field name for finding close matches,
+// ex: "Employee Name", "employee_name" and "employeeName" have the same key
+func mergeFieldKey(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// mergeFieldNames returns sorted names of merge fields
+func mergeFieldNames(mergeFields []MergeFieldDetail) []string {
+	names := make([]string, 0, len(mergeFields))
+	for _, mergeField := range mergeFields {
+		names = append(names, strconv.Quote(mergeField.Name))
+	}
+	sort.Strings(names)
+	return names
+}
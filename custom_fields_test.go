@@ -0,0 +1,137 @@
+package hellosign_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+)
+
+func TestCustomFieldsFromStruct(t *testing.T) {
+	type payroll struct {
+		EmployeeName string  `hellosign:"Employee Name,required"`
+		Salary       float64 `hellosign:"Salary,editor=Client"`
+		Remote       bool    `hellosign:"Remote"`
+		Bonus        *int    `hellosign:"Bonus"`
+		Manager      *string `hellosign:"Manager,required"`
+		Ignored      string
+	}
+
+	bonus := 500
+	manager := "Jack"
+
+	tests := map[string]struct {
+		v              interface{}
+		expectedFields []hellosign.CustomFieldsDetail
+		expectedError  []string
+	}{
+		"success": {
+			v: &payroll{EmployeeName: "Jill", Salary: 1250.5, Remote: true, Bonus: &bonus, Manager: &manager},
+			expectedFields: []hellosign.CustomFieldsDetail{
+				{Name: "Employee Name", FieldType: hellosign.FieldText, Value: "Jill", Required: true},
+				{Name: "Salary", FieldType: hellosign.FieldText, Value: "1250.5", Editor: "Client"},
+				{Name: "Remote", FieldType: hellosign.FieldCheckBox, Value: true},
+				{Name: "Bonus", FieldType: hellosign.FieldText, Value: "500"},
+				{Name: "Manager", FieldType: hellosign.FieldText, Value: "Jack", Required: true},
+			},
+		},
+		"missing required": {
+			v: payroll{},
+			expectedError: []string{
+				"custom_fields[Employee Name]",
+				"custom_fields[Manager]",
+			},
+		},
+		"unsupported type": {
+			v: struct {
+				Tags []string `hellosign:"Tags"`
+			}{},
+			expectedError: []string{"custom_fields[Tags]"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is := is.New(t)
+
+			fields, err := hellosign.CustomFieldsFromStruct(test.v)
+			if len(test.expectedError) > 0 {
+				var errs hellosign.ValidationErrors
+				is.True(errors.As(err, &errs))
+				is.Equal(len(test.expectedError), len(errs))
+				for i, field := range test.expectedError {
+					is.Equal(field, errs[i].Field)
+				}
+				return
+			}
+
+			is.NoErr(err)
+			is.Equal(test.expectedFields, fields)
+		})
+	}
+
+	_, err := hellosign.CustomFieldsFromStruct("Jill")
+	is.New(t).True(err != nil)
+}
+
+func TestValidateCustomFields(t *testing.T) {
+	mergeFields := []hellosign.MergeFieldDetail{
+		{Name: "Employee Name", Type: hellosign.FieldText},
+		{Name: "Remote", Type: hellosign.FieldCheckBox},
+	}
+
+	tests := map[string]struct {
+		fields        []hellosign.CustomFieldsDetail
+		expectedError []string
+	}{
+		"success": {
+			fields: []hellosign.CustomFieldsDetail{
+				{Name: "Employee Name", Value: "Jill"},
+				{Name: "Remote", Value: true},
+			},
+		},
+		"close match": {
+			fields: []hellosign.CustomFieldsDetail{
+				{Name: "employee_name", Value: "Jill"},
+			},
+			expectedError: []string{`custom_fields[employee_name]: unknown merge field, did you mean "Employee Name"?`},
+		},
+		"unknown": {
+			fields: []hellosign.CustomFieldsDetail{
+				{Name: "Salary", Value: "1250"},
+			},
+			expectedError: []string{`custom_fields[Salary]: unknown merge field, template declares "Employee Name", "Remote"`},
+		},
+		"type mismatch": {
+			fields: []hellosign.CustomFieldsDetail{
+				{Name: "Employee Name", Value: true},
+				{Name: "Remote", Value: "yes"},
+			},
+			expectedError: []string{
+				"custom_fields[Employee Name]: text merge field requires a text value",
+				"custom_fields[Remote]: checkbox merge field requires a bool value",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is := is.New(t)
+
+			err := hellosign.ValidateCustomFields(test.fields, mergeFields)
+			if len(test.expectedError) == 0 {
+				is.NoErr(err)
+				return
+			}
+
+			var errs hellosign.ValidationErrors
+			is.True(errors.As(err, &errs))
+			is.Equal(len(test.expectedError), len(errs))
+			for i, msg := range test.expectedError {
+				is.Equal(msg, errs[i].Error())
+			}
+		})
+	}
+}
@@ -1,16 +1,79 @@
 package hellosign
 
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateFormat is a format of date signed fields
+type DateFormat string
+
 const (
 	// DateFormat1 is date format for MM / DD / YYYY ex: 10 / 16 / 2020
-	DateFormat1 = "MM / DD/ YYYY"
+	DateFormat1 DateFormat = "MM / DD / YYYY"
 	// DateFormat2 is date format for MM - DD - YYYY ex: 10 - 16 - 2020
-	DateFormat2 = "MM - DD - YYYY"
+	DateFormat2 DateFormat = "MM - DD - YYYY"
 	// DateFormat3 is date format for DD / MM / YYYY ex: 16 / 10 / 2020
-	DateFormat3 = "DD / MM / YYYY"
+	DateFormat3 DateFormat = "DD / MM / YYYY"
 	// DateFormat4 is date format for DD - MM - YYYY ex: 16 - 10 - 2020
-	DateFormat4 = "DD - MM - YYYY"
+	DateFormat4 DateFormat = "DD - MM - YYYY"
 	// DateFormat5 is date format for YYYY / MM / DD ex: 2020 / 10 / 16
-	DateFormat5 = "YYYY / MM / DD"
+	DateFormat5 DateFormat = "YYYY / MM / DD"
 	// DateFormat6 is date format for YYYY - MM - DD ex: 2020 - 10 - 16
-	DateFormat6 = "YYYY - MM - DD"
+	DateFormat6 DateFormat = "YYYY - MM - DD"
 )
+
+// dateFormatLayouts is go time layout of each date format
+var dateFormatLayouts = map[DateFormat]string{
+	DateFormat1: "01 / 02 / 2006",
+	DateFormat2: "01 - 02 - 2006",
+	DateFormat3: "02 / 01 / 2006",
+	DateFormat4: "02 - 01 - 2006",
+	DateFormat5: "2006 / 01 / 02",
+	DateFormat6: "2006 - 01 - 02",
+}
+
+// DateFormatFromLayout returns date format of a go time layout, ex: "01/02/2006" is DateFormat1.
+// Spaces in layout are ignored.
+func DateFormatFromLayout(layout string) (DateFormat, error) {
+	layout = removeSpaces(layout)
+	for dateFormat, l := range dateFormatLayouts {
+		if removeSpaces(l) == layout {
+			return dateFormat, nil
+		}
+	}
+	return "", fmt.Errorf("hellosign: no date format for layout %q", layout)
+}
+
+// Validate check if the date format is supported by hellosign
+func (d DateFormat) Validate() error {
+	if _, ok := dateFormatLayouts[d]; !ok {
+		return fmt.Errorf("hellosign: unknown date format %q", string(d))
+	}
+	return nil
+}
+
+// Layout returns go time layout of the date format, ex: "01 / 02 / 2006"
+func (d DateFormat) Layout() string {
+	return dateFormatLayouts[d]
+}
+
+// Format returns t formatted in the date format
+func (d DateFormat) Format(t time.Time) string {
+	return t.Format(d.Layout())
+}
+
+// Parse parses a date written in the date format, spaces around separators are optional
+func (d DateFormat) Parse(value string) (time.Time, error) {
+	err := d.Validate()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(removeSpaces(d.Layout()), removeSpaces(value))
+}
+
+// removeSpaces removes all spaces in s
+func removeSpaces(s string) string {
+	return strings.ReplaceAll(s, " ", "")
+}
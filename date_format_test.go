@@ -0,0 +1,83 @@
+package hellosign_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+)
+
+func TestDateFormat(t *testing.T) {
+	date := time.Date(2020, time.October, 16, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		dateFormat   hellosign.DateFormat
+		expectedDate string
+	}{
+		"month day year": {
+			dateFormat:   hellosign.DateFormat1,
+			expectedDate: "10 / 16 / 2020",
+		},
+		"day month year": {
+			dateFormat:   hellosign.DateFormat4,
+			expectedDate: "16 - 10 - 2020",
+		},
+		"year month day": {
+			dateFormat:   hellosign.DateFormat5,
+			expectedDate: "2020 / 10 / 16",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			is.NoErr(test.dateFormat.Validate())
+			is.Equal(test.expectedDate, test.dateFormat.Format(date))
+
+			parsed, err := test.dateFormat.Parse(test.expectedDate)
+			is.NoErr(err)
+			is.Equal(date, parsed)
+
+			dateFormat, err := hellosign.DateFormatFromLayout(test.dateFormat.Layout())
+			is.NoErr(err)
+			is.Equal(test.dateFormat, dateFormat)
+		})
+	}
+}
+
+func TestDateFormatFromLayout(t *testing.T) {
+	tests := map[string]struct {
+		layout             string
+		expectedDateFormat hellosign.DateFormat
+		expectedError      error
+	}{
+		"without spaces": {
+			layout:             "01/02/2006",
+			expectedDateFormat: hellosign.DateFormat1,
+		},
+		"iso date": {
+			layout:             "2006-01-02",
+			expectedDateFormat: hellosign.DateFormat6,
+		},
+		"unsupported": {
+			layout:        "Jan 2, 2006",
+			expectedError: errors.New(`hellosign: no date format for layout "Jan2,2006"`),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			dateFormat, err := hellosign.DateFormatFromLayout(test.layout)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedDateFormat, dateFormat)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package hellosign
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// EmbeddedAPI is a service to embedded API
+type EmbeddedAPI service
+
+// EmbeddedSignURL represent embedded sign url response
+type EmbeddedSignURL struct {
+	Embedded EmbeddedSignURLDetail `json:"embedded"`
+	Warnings []Warnings            `json:"warnings,omitempty"`
+}
+
+// EmbeddedSignURLDetail represent url to open the signing page in an iframe
+type EmbeddedSignURLDetail struct {
+	SignURL   string   `json:"sign_url"`
+	ExpiresAt UnixTime `json:"expires_at"`
+}
+
+// EmbeddedEditURL represent embedded template edit url response
+type EmbeddedEditURL struct {
+	Embedded EmbeddedEditURLDetail `json:"embedded"`
+	Warnings []Warnings            `json:"warnings,omitempty"`
+}
+
+// EmbeddedEditURLDetail represent url to open the template editor in an iframe
+type EmbeddedEditURLDetail struct {
+	EditURL   string   `json:"edit_url"`
+	ExpiresAt UnixTime `json:"expires_at"`
+}
+
+// EditURLParam is request param for get embedded template edit url.
+// MergeFields replaces the merge fields of the template and CCRoles its cc roles.
+// PreviewOnly opens the template in preview mode, which can't be edited.
+type EditURLParam struct {
+	TestMode    bool
+	MergeFields []MergeFieldDetail
+	CCRoles     []string
+	PreviewOnly bool
+}
+
+const (
+	// subURLEmbedded is sub url path for embedded
+	subURLEmbedded = "/embedded"
+)
+
+var (
+	// subURLEmbeddedSignURL is sub url path for get embedded sign url
+	subURLEmbeddedSignURL = subURLEmbedded + "/sign_url"
+
+	// subURLEmbeddedEditURL is sub url path for get embedded template edit url
+	subURLEmbeddedEditURL = subURLEmbedded + "/edit_url"
+)
+
+// GetSignURL will return a url to be opened in an iframe to sign a signature.
+// The url expires shortly, so it must be requested right before it is used.
+// Ref: https://app.hellosign.com/api/reference#get_embedded_sign_url
+func (e *EmbeddedAPI) GetSignURL(ctx context.Context, signatureID string) (EmbeddedSignURL, error) {
+	resp, err := e.client.callAPI(
+		ctx,
+		requestParam{
+			path:   e.client.BaseURL + subURLEmbeddedSignURL + "/" + signatureID,
+			method: http.MethodGet,
+		},
+	)
+	if err != nil {
+		return EmbeddedSignURL{}, err
+	}
+	defer resp.Body.Close()
+
+	signURL := EmbeddedSignURL{}
+	err = json.NewDecoder(resp.Body).Decode(&signURL)
+	if err != nil {
+		return EmbeddedSignURL{}, err
+	}
+
+	return signURL, nil
+}
+
+// GetEditURL will return a url to be opened in an iframe to edit a template.
+// The url expires shortly, so it must be requested right before it is used.
+// Ref: https://app.hellosign.com/api/reference#get_embedded_template_edit_url
+func (e *EmbeddedAPI) GetEditURL(ctx context.Context, templateID string, p EditURLParam) (EmbeddedEditURL, error) {
+	req, err := e.client.prepareRequest(
+		ctx,
+		requestParam{
+			path:   e.client.BaseURL + subURLEmbeddedEditURL + "/" + templateID,
+			method: http.MethodGet,
+		})
+	if err != nil {
+		return EmbeddedEditURL{}, err
+	}
+
+	q := req.URL.Query()
+	if p.TestMode {
+		q.Add("test_mode", "1")
+	}
+	if len(p.MergeFields) > 0 {
+		mergeFields, err := json.Marshal(p.MergeFields)
+		if err != nil {
+			return EmbeddedEditURL{}, err
+		}
+		q.Add("merge_fields", string(mergeFields))
+	}
+	for i, role := range p.CCRoles {
+		q.Add(fmt.Sprintf("cc_roles[%d]", i), role)
+	}
+	if p.PreviewOnly {
+		q.Add("preview_only", "1")
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := e.client.executeRequest(req)
+	if err != nil {
+		return EmbeddedEditURL{}, err
+	}
+	defer resp.Body.Close()
+
+	editURL := EmbeddedEditURL{}
+	err = json.NewDecoder(resp.Body).Decode(&editURL)
+	if err != nil {
+		return EmbeddedEditURL{}, err
+	}
+
+	return editURL, nil
+}
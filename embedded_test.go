@@ -0,0 +1,153 @@
+package hellosign_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+	"github.com/milhamhidayat/go-hellosign-sdk/testdata"
+)
+
+func TestEmbedded_GetSignURL(t *testing.T) {
+	is := is.New(t)
+
+	signURLJSON := testdata.GetGolden(t, "embedded-sign-url")
+	signURL := hellosign.EmbeddedSignURL{}
+	err := json.Unmarshal(signURLJSON, &signURL)
+	is.NoErr(err)
+
+	errNotFoundJSON := testdata.GetGolden(t, "signature-request-err-not-found")
+
+	tests := map[string]struct {
+		signatureID     string
+		signURLResponse http.Response
+		expectedSignURL hellosign.EmbeddedSignURL
+		expectedError   error
+	}{
+		"success": {
+			signatureID: "50e3542f738adfa7ddd4cbd4c00d2a8ab6e4194b",
+			signURLResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(signURLJSON)),
+				Header:     make(http.Header),
+			},
+			expectedSignURL: signURL,
+			expectedError:   nil,
+		},
+		"not found": {
+			signatureID: "50e3542f738adfa7ddd4cbd4c00d2a8ab6e4194b",
+			signURLResponse: http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(bytes.NewReader(errNotFoundJSON)),
+				Header:     make(http.Header),
+			},
+			expectedSignURL: hellosign.EmbeddedSignURL{},
+			expectedError:   errors.New("not_found: Not found"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal("/v3/embedded/sign_url/"+test.signatureID, req.URL.Path)
+				return &test.signURLResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.EmbeddedAPI.GetSignURL(context.TODO(), test.signatureID)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedSignURL, resp)
+		})
+	}
+}
+
+func TestEmbedded_GetEditURL(t *testing.T) {
+	is := is.New(t)
+
+	editURLJSON := testdata.GetGolden(t, "embedded-edit-url")
+	editURL := hellosign.EmbeddedEditURL{}
+	err := json.Unmarshal(editURLJSON, &editURL)
+	is.NoErr(err)
+
+	errNotFoundJSON := testdata.GetGolden(t, "signature-request-err-not-found")
+
+	tests := map[string]struct {
+		templateID      string
+		param           hellosign.EditURLParam
+		expectedQuery   map[string]string
+		editURLResponse http.Response
+		expectedEditURL hellosign.EmbeddedEditURL
+		expectedError   error
+	}{
+		"success": {
+			templateID: "f57db65d3f933b5316d398057a36176831451a35",
+			param: hellosign.EditURLParam{
+				MergeFields: []hellosign.MergeFieldDetail{
+					{Name: "Cost", Type: hellosign.FieldText},
+				},
+				CCRoles:     []string{"Accounting"},
+				PreviewOnly: true,
+			},
+			expectedQuery: map[string]string{
+				"merge_fields": `[{"name":"Cost","type":"text"}]`,
+				"cc_roles[0]":  "Accounting",
+				"preview_only": "1",
+				"test_mode":    "",
+			},
+			editURLResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(editURLJSON)),
+				Header:     make(http.Header),
+			},
+			expectedEditURL: editURL,
+			expectedError:   nil,
+		},
+		"not found": {
+			templateID: "f57db65d3f933b5316d398057a36176831451a35",
+			editURLResponse: http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(bytes.NewReader(errNotFoundJSON)),
+				Header:     make(http.Header),
+			},
+			expectedEditURL: hellosign.EmbeddedEditURL{},
+			expectedError:   errors.New("not_found: Not found"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal(http.MethodGet, req.Method)
+				is.Equal("/v3/embedded/edit_url/"+test.templateID, req.URL.Path)
+				for k, v := range test.expectedQuery {
+					is.Equal(v, req.URL.Query().Get(k))
+				}
+				return &test.editURLResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.EmbeddedAPI.GetEditURL(context.TODO(), test.templateID, test.param)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedEditURL, resp)
+		})
+	}
+}
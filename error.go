@@ -1,5 +1,13 @@
 package hellosign
 
+import "errors"
+
+// ErrMissingClientID is returned when an embedded request is made without api app client id
+var ErrMissingClientID = errors.New("hellosign: client id is required")
+
+// ErrBulkSendSigners is returned when a bulk send has none or both of signer file and signer list
+var ErrBulkSendSigners = errors.New("hellosign: either signer file or signer list is required")
+
 // Error is an error response from hellosign
 // To see list of error from hellosign,
 // Please access: https://app.hellosign.com/api/reference#ErrorNames
@@ -8,7 +8,7 @@ type Event struct {
 
 // EventDetail is detail for event
 type EventDetail struct {
-	EventTime     int64         `json:"event_time"`
+	EventTime     UnixTime      `json:"event_time"`
 	EventType     string        `json:"event_type"`
 	EventHash     string        `json:"event_hash"`
 	EventMetadata EventMetadata `json:"event_metadata"`
@@ -20,3 +20,35 @@ const (
 	// FieldCheckboxMerge is check box mrege field type
 	FieldCheckboxMerge = "checkbox-merge"
 )
+
+const (
+	// FieldValidationNumbersOnly is text field validation for numbers only
+	FieldValidationNumbersOnly = "numbers_only"
+	// FieldValidationLettersOnly is text field validation for letters only
+	FieldValidationLettersOnly = "letters_only"
+	// FieldValidationPhoneNumber is text field validation for phone number
+	FieldValidationPhoneNumber = "phone_number"
+	// FieldValidationBankRoutingNumber is text field validation for bank routing number
+	FieldValidationBankRoutingNumber = "bank_routing_number"
+	// FieldValidationBankAccountNumber is text field validation for bank account number
+	FieldValidationBankAccountNumber = "bank_account_number"
+	// FieldValidationEmailAddress is text field validation for email address
+	FieldValidationEmailAddress = "email_address"
+	// FieldValidationZipCode is text field validation for zip code
+	FieldValidationZipCode = "zip_code"
+	// FieldValidationSocialSecurityNumber is text field validation for social security number
+	FieldValidationSocialSecurityNumber = "social_security_number"
+	// FieldValidationEmployerIdentificationNumber is text field validation for employer identification number
+	FieldValidationEmployerIdentificationNumber = "employer_identification_number"
+)
+
+const (
+	// FieldGroupRequirementZeroOrOne is requirement for a field group where at most one field may be filled
+	FieldGroupRequirementZeroOrOne = "require_0-1"
+	// FieldGroupRequirementOne is requirement for a field group where exactly one field must be filled
+	FieldGroupRequirementOne = "require_1"
+	// FieldGroupRequirementOneOrMore is requirement for a field group where at least one field must be filled
+	FieldGroupRequirementOneOrMore = "require_1-ormore"
+	// FieldGroupRequirementZeroOrMore is requirement for a field group where any field may be filled
+	FieldGroupRequirementZeroOrMore = "require_0-ormore"
+)
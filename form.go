@@ -0,0 +1,182 @@
+package hellosign
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"sort"
+	"strconv"
+)
+
+// File is a local document uploaded to hellosign
+type File struct {
+	// Name is the file name shown in hellosign, ex: contract.pdf
+	Name string
+	// Reader is the content of the file
+	Reader io.Reader
+}
+
+// formField is a single field in a multipart form
+type formField struct {
+	key   string
+	value string
+}
+
+// form is an ordered list of multipart form fields.
+// Empty values are not added, so hellosign will use its default value.
+type form []formField
+
+// add appends a field to the form
+func (f *form) add(key, value string) {
+	if value == "" {
+		return
+	}
+	*f = append(*f, formField{key: key, value: value})
+}
+
+// addInt appends a non zero integer field to the form
+func (f *form) addInt(key string, value int) {
+	if value == 0 {
+		return
+	}
+	f.add(key, strconv.Itoa(value))
+}
+
+// addBool appends a true boolean field to the form as "1"
+func (f *form) addBool(key string, value bool) {
+	if !value {
+		return
+	}
+	f.add(key, "1")
+}
+
+// addUnixTime appends a set unix time field to the form as unix timestamp
+func (f *form) addUnixTime(key string, value UnixTime) {
+	if value.IsZero() {
+		return
+	}
+	f.add(key, strconv.FormatInt(int64(value), 10))
+}
+
+// addJSON appends a field encoded as json string to the form
+func (f *form) addJSON(key string, value interface{}) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	f.add(key, string(b))
+	return nil
+}
+
+// addList appends a list field to the form, ex: cc_email_addresses[0]
+func (f *form) addList(key string, values []string) {
+	for i, v := range values {
+		f.add(fmt.Sprintf("%s[%d]", key, i), v)
+	}
+}
+
+// addMetadata appends metadata fields to the form, ex: metadata[key]
+func (f *form) addMetadata(metadata map[string]interface{}) {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		f.add("metadata["+k+"]", fmt.Sprint(metadata[k]))
+	}
+}
+
+// addCCs appends cc email addresses keyed by template role to the form, ex: ccs[Role][email_address]
+func (f *form) addCCs(ccs map[string]string) {
+	roles := make([]string, 0, len(ccs))
+	for role := range ccs {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	for _, role := range roles {
+		f.add("ccs["+role+"][email_address]", ccs[role])
+	}
+}
+
+// addCustomFields appends custom fields to the form as json string
+func (f *form) addCustomFields(fields []CustomFieldsDetail) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return f.addJSON("custom_fields", customFieldValues(fields))
+}
+
+// customFieldValue is a custom field as expected by hellosign when sending a request
+type customFieldValue struct {
+	Name     string      `json:"name"`
+	Value    interface{} `json:"value"`
+	Editor   string      `json:"editor,omitempty"`
+	Required bool        `json:"required,omitempty"`
+}
+
+// customFieldValues converts custom fields into values for sending a request
+func customFieldValues(fields []CustomFieldsDetail) []customFieldValue {
+	values := make([]customFieldValue, 0, len(fields))
+	for _, field := range fields {
+		values = append(values, customFieldValue{
+			Name:     field.Name,
+			Value:    field.Value,
+			Editor:   field.Editor,
+			Required: field.Required,
+		})
+	}
+	return values
+}
+
+// formFile is a single file part in a multipart form
+type formFile struct {
+	key  string
+	file File
+}
+
+// indexedFiles returns files as form file parts keyed by key[0], key[1], ...
+func indexedFiles(key string, files []File) []formFile {
+	parts := make([]formFile, 0, len(files))
+	for i, file := range files {
+		parts = append(parts, formFile{key: fmt.Sprintf("%s[%d]", key, i), file: file})
+	}
+	return parts
+}
+
+// encode writes all fields and files into a multipart body
+func (f form) encode(files []formFile) (*bytes.Buffer, *multipart.Writer, error) {
+	var payload bytes.Buffer
+	writer := multipart.NewWriter(&payload)
+
+	for _, field := range f {
+		err := writer.WriteField(field.key, field.value)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, file := range files {
+		part, err := writer.CreateFormFile(file.key, file.file.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		_, err = io.Copy(part, file.file.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	err := writer.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &payload, writer, nil
+}
@@ -0,0 +1,363 @@
+package hellosign
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// pointsPerInch is the number of points in an inch
+	pointsPerInch = 72
+
+	// millimetersPerInch is the number of millimeters in an inch
+	millimetersPerInch = 25.4
+)
+
+// PageSize is a size of a document page in points, 1 point is 1/72 inch
+type PageSize struct {
+	Width  int
+	Height int
+}
+
+var (
+	// PageSizeLetter is US letter page size, 8.5 x 11 inch
+	PageSizeLetter = PageSize{Width: 612, Height: 792}
+	// PageSizeLegal is US legal page size, 8.5 x 14 inch
+	PageSizeLegal = PageSize{Width: 612, Height: 1008}
+	// PageSizeA4 is A4 page size, 210 x 297 mm
+	PageSizeA4 = PageSize{Width: 595, Height: 842}
+)
+
+// FromRight returns x coordinate of a field with width, placed margin points from the right edge
+func (s PageSize) FromRight(margin, width int) int {
+	return s.Width - margin - width
+}
+
+// FromBottom returns y coordinate of a field with height, placed margin points from the bottom edge
+func (s PageSize) FromBottom(margin, height int) int {
+	return s.Height - margin - height
+}
+
+// Inch converts inches to points
+func Inch(v float64) int {
+	return int(math.Round(v * pointsPerInch))
+}
+
+// Millimeter converts millimeters to points
+func Millimeter(v float64) int {
+	return int(math.Round(v / millimetersPerInch * pointsPerInch))
+}
+
+// FormField is a form field to be placed in a page.
+// Create it with a field constructor, ex: TextField, then chain its setters.
+type FormField struct {
+	detail FormFieldDetail
+}
+
+// newFormField returns form field with default size of the field type
+func newFormField(fieldType, apiID string, signer, width, height int) FormField {
+	return FormField{
+		detail: FormFieldDetail{
+			APIID:  apiID,
+			Type:   fieldType,
+			Signer: signer,
+			Width:  width,
+			Height: height,
+		},
+	}
+}
+
+// TextField returns a text field for signer index
+func TextField(apiID string, signer int) FormField {
+	return newFormField(FieldText, apiID, signer, 200, 20)
+}
+
+// CheckboxField returns a checkbox field for signer index
+func CheckboxField(apiID string, signer int) FormField {
+	return newFormField(FieldCheckBox, apiID, signer, 14, 14)
+}
+
+// SignatureField returns a signature field for signer index
+func SignatureField(apiID string, signer int) FormField {
+	return newFormField(FieldSignature, apiID, signer, 200, 40)
+}
+
+// InitialsField returns an initials field for signer index
+func InitialsField(apiID string, signer int) FormField {
+	return newFormField(FieldInitials, apiID, signer, 60, 30)
+}
+
+// DateSignedField returns a field filled with the date the signer signed
+func DateSignedField(apiID string, signer int) FormField {
+	return newFormField(FieldDateSigned, apiID, signer, 100, 20)
+}
+
+// DropdownField returns a dropdown field with options for signer index
+func DropdownField(apiID string, signer int, options ...string) FormField {
+	f := newFormField(FieldDropdown, apiID, signer, 120, 20)
+	f.detail.Options = options
+	return f
+}
+
+// RadioField returns a radio button for signer index which belongs to group.
+// The group must be registered with FormFieldsBuilder.RadioGroup.
+func RadioField(apiID string, signer int, group string) FormField {
+	f := newFormField(FieldRadio, apiID, signer, 14, 14)
+	f.detail.Group = group
+	return f
+}
+
+// At sets the position of the field from the top left corner of the page in points
+func (f FormField) At(x, y int) FormField {
+	f.detail.X = x
+	f.detail.Y = y
+	return f
+}
+
+// Size sets the width and height of the field in points
+func (f FormField) Size(width, height int) FormField {
+	f.detail.Width = width
+	f.detail.Height = height
+	return f
+}
+
+// Required sets whether the signer must fill the field
+func (f FormField) Required(required bool) FormField {
+	f.detail.Required = required
+	return f
+}
+
+// Name sets the name of the field shown to the signer
+func (f FormField) Name(name string) FormField {
+	f.detail.Name = name
+	return f
+}
+
+// Validation sets validation type of a text field, one of FieldValidation constants
+func (f FormField) Validation(validationType string) FormField {
+	f.detail.ValidationType = validationType
+	return f
+}
+
+// Default sets the selected option of a dropdown field
+func (f FormField) Default(content string) FormField {
+	f.detail.Content = content
+	return f
+}
+
+// Detail returns the form field detail as sent to hellosign
+func (f FormField) Detail() FormFieldDetail {
+	return f.detail
+}
+
+// FormFieldsBuilder builds form fields per document for SignatureRequestPayload.
+// Documents are in the same order as the uploaded files or file urls.
+type FormFieldsBuilder struct {
+	documents []*DocumentBuilder
+	groups    []FormFieldGroupDetail
+}
+
+// DocumentBuilder builds form fields of a single document
+type DocumentBuilder struct {
+	pages []*PageBuilder
+}
+
+// PageBuilder builds form fields of a single page in a document
+type PageBuilder struct {
+	number int
+	size   PageSize
+	fields []FormField
+}
+
+// NewFormFieldsBuilder returns an empty form fields builder
+func NewFormFieldsBuilder() *FormFieldsBuilder {
+	return &FormFieldsBuilder{}
+}
+
+// Document adds the next document and returns its builder
+func (b *FormFieldsBuilder) Document() *DocumentBuilder {
+	d := &DocumentBuilder{}
+	b.documents = append(b.documents, d)
+	return d
+}
+
+// RadioGroup registers a group for radio fields, requirement is one of FieldGroupRequirement constants
+func (b *FormFieldsBuilder) RadioGroup(groupID, label, requirement string) *FormFieldsBuilder {
+	b.groups = append(b.groups, FormFieldGroupDetail{
+		GroupID:     groupID,
+		GroupLabel:  label,
+		Requirement: requirement,
+	})
+	return b
+}
+
+// Page adds a page of the document and returns its builder, number starts from 1
+func (d *DocumentBuilder) Page(number int, size PageSize) *PageBuilder {
+	p := &PageBuilder{
+		number: number,
+		size:   size,
+	}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// Add adds form fields to the page
+func (p *PageBuilder) Add(fields ...FormField) *PageBuilder {
+	p.fields = append(p.fields, fields...)
+	return p
+}
+
+// Size returns the size of the page
+func (p *PageBuilder) Size() PageSize {
+	return p.size
+}
+
+// Build validates every form field and returns form fields per document and form field groups.
+// It returns ValidationErrors which contains every invalid field.
+func (b *FormFieldsBuilder) Build() ([][]FormFieldDetail, []FormFieldGroupDetail, error) {
+	errs := ValidationErrors{}
+
+	groups := map[string]int{}
+	for i, group := range b.groups {
+		field := fmt.Sprintf("form_field_groups[%d]", i)
+		if _, ok := groups[group.GroupID]; ok {
+			errs.add(field+"[group_id]", "group %q is registered more than once", group.GroupID)
+		}
+		if !formFieldGroupRequirements[group.Requirement] {
+			errs.add(field+"[requirement]", "unknown requirement %q", group.Requirement)
+		}
+		groups[group.GroupID] = 0
+	}
+
+	apiIDs := map[string]bool{}
+	documents := make([][]FormFieldDetail, 0, len(b.documents))
+	for i, document := range b.documents {
+		details := []FormFieldDetail{}
+		for _, page := range document.pages {
+			for _, f := range page.fields {
+				detail := f.detail
+				detail.Page = page.number
+
+				field := fmt.Sprintf("form_fields_per_document[%d][%d]", i, len(details))
+				if detail.APIID != "" {
+					if apiIDs[detail.APIID] {
+						errs.add(field+"[api_id]", "api id %q is used by another field", detail.APIID)
+					}
+					apiIDs[detail.APIID] = true
+				}
+
+				validateFormFieldPosition(&errs, field, detail, page)
+				validateFormFieldType(&errs, field, detail, groups)
+
+				details = append(details, detail)
+			}
+		}
+		documents = append(documents, details)
+	}
+
+	for i, group := range b.groups {
+		if groups[group.GroupID] < 2 {
+			errs.add(fmt.Sprintf("form_field_groups[%d]", i), "group %q must have at least 2 radio fields", group.GroupID)
+		}
+	}
+
+	err := errs.err()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return documents, b.groups, nil
+}
+
+// Apply builds the form fields and sets them into the payload
+func (b *FormFieldsBuilder) Apply(p *SignatureRequestPayload) error {
+	documents, groups, err := b.Build()
+	if err != nil {
+		return err
+	}
+
+	p.FormFieldsPerDocument = documents
+	p.FormFieldGroups = groups
+	return nil
+}
+
+// formFieldGroupRequirements is all known form field group requirements
+var formFieldGroupRequirements = map[string]bool{
+	FieldGroupRequirementZeroOrOne:  true,
+	FieldGroupRequirementOne:        true,
+	FieldGroupRequirementOneOrMore:  true,
+	FieldGroupRequirementZeroOrMore: true,
+}
+
+// fieldValidationTypes is all known text field validation types
+var fieldValidationTypes = map[string]bool{
+	FieldValidationNumbersOnly:                  true,
+	FieldValidationLettersOnly:                  true,
+	FieldValidationPhoneNumber:                  true,
+	FieldValidationBankRoutingNumber:            true,
+	FieldValidationBankAccountNumber:            true,
+	FieldValidationEmailAddress:                 true,
+	FieldValidationZipCode:                      true,
+	FieldValidationSocialSecurityNumber:         true,
+	FieldValidationEmployerIdentificationNumber: true,
+}
+
+// validateFormFieldPosition check that the field has a size and lies inside the page
+func validateFormFieldPosition(errs *ValidationErrors, field string, detail FormFieldDetail, page *PageBuilder) {
+	if page.number < 1 {
+		errs.add(field+"[page]", "page number must start from 1")
+	}
+	if detail.Signer < 0 {
+		errs.add(field+"[signer]", "signer index must not be negative")
+	}
+	if detail.Width <= 0 || detail.Height <= 0 {
+		errs.add(field, "width and height must be greater than 0")
+		return
+	}
+	if detail.X < 0 || detail.Y < 0 || detail.X+detail.Width > page.size.Width || detail.Y+detail.Height > page.size.Height {
+		errs.add(field, "field at (%d, %d) with size %dx%d is outside the %dx%d page",
+			detail.X, detail.Y, detail.Width, detail.Height, page.size.Width, page.size.Height)
+	}
+}
+
+// validateFormFieldType check rules specific to the field type
+func validateFormFieldType(errs *ValidationErrors, field string, detail FormFieldDetail, groups map[string]int) {
+	if detail.ValidationType != "" {
+		if detail.Type != FieldText {
+			errs.add(field+"[validation_type]", "only text field can have validation")
+		} else if !fieldValidationTypes[detail.ValidationType] {
+			errs.add(field+"[validation_type]", "unknown validation type %q", detail.ValidationType)
+		}
+	}
+
+	switch detail.Type {
+	case FieldDropdown:
+		if len(detail.Options) == 0 {
+			errs.add(field+"[options]", "dropdown must have at least one option")
+		}
+		if detail.Content != "" && !containsString(detail.Options, detail.Content) {
+			errs.add(field+"[content]", "default %q is not one of the options", detail.Content)
+		}
+	case FieldRadio:
+		count, ok := groups[detail.Group]
+		if !ok {
+			errs.add(field+"[group]", "radio group %q is not registered", detail.Group)
+			return
+		}
+		groups[detail.Group] = count + 1
+	default:
+		if len(detail.Options) > 0 {
+			errs.add(field+"[options]", "only dropdown field can have options")
+		}
+	}
+}
+
+// containsString check if s is one of values
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package hellosign_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+)
+
+func TestFormFieldsBuilder_Build(t *testing.T) {
+	is := is.New(t)
+
+	b := hellosign.NewFormFieldsBuilder().
+		RadioGroup("shipping", "Shipping", hellosign.FieldGroupRequirementOne)
+
+	letter := b.Document().Page(1, hellosign.PageSizeLetter)
+	letter.Add(
+		hellosign.TextField("employee_id", 0).At(hellosign.Inch(1), hellosign.Inch(1)).Validation(hellosign.FieldValidationNumbersOnly).Required(true),
+		hellosign.DropdownField("department", 0, "Finance", "Engineering").At(72, 120).Default("Engineering"),
+		hellosign.RadioField("express", 0, "shipping").At(72, 160),
+		hellosign.RadioField("regular", 0, "shipping").At(100, 160),
+		hellosign.SignatureField("signature", 0).At(letter.Size().FromRight(72, 200), letter.Size().FromBottom(72, 40)),
+	)
+
+	b.Document().Page(2, hellosign.PageSizeA4).Add(
+		hellosign.DateSignedField("date", 1).At(hellosign.Millimeter(20), hellosign.Millimeter(20)),
+	)
+
+	documents, groups, err := b.Build()
+	is.NoErr(err)
+	is.Equal(2, len(documents))
+	is.Equal(5, len(documents[0]))
+	is.Equal([]hellosign.FormFieldGroupDetail{
+		{GroupID: "shipping", GroupLabel: "Shipping", Requirement: hellosign.FieldGroupRequirementOne},
+	}, groups)
+
+	is.Equal(hellosign.FormFieldDetail{
+		APIID:          "employee_id",
+		Type:           hellosign.FieldText,
+		X:              72,
+		Y:              72,
+		Page:           1,
+		Width:          200,
+		Height:         20,
+		Required:       true,
+		ValidationType: hellosign.FieldValidationNumbersOnly,
+	}, documents[0][0])
+	is.Equal(340, documents[0][4].X)
+	is.Equal(680, documents[0][4].Y)
+	is.Equal(hellosign.FormFieldDetail{
+		APIID:  "date",
+		Type:   hellosign.FieldDateSigned,
+		X:      57,
+		Y:      57,
+		Page:   2,
+		Width:  100,
+		Height: 20,
+		Signer: 1,
+	}, documents[1][0])
+
+	payload := hellosign.SignatureRequestPayload{}
+	err = b.Apply(&payload)
+	is.NoErr(err)
+	is.Equal(documents, payload.FormFieldsPerDocument)
+	is.Equal(groups, payload.FormFieldGroups)
+}
+
+func TestFormFieldsBuilder_BuildInvalid(t *testing.T) {
+	is := is.New(t)
+
+	b := hellosign.NewFormFieldsBuilder().
+		RadioGroup("shipping", "Shipping", "require_2").
+		RadioGroup("gift", "Gift wrap", hellosign.FieldGroupRequirementOne)
+
+	b.Document().Page(1, hellosign.PageSizeLetter).Add(
+		hellosign.TextField("name", 0).At(500, 10),
+		hellosign.CheckboxField("name", 0).At(10, 10).Validation(hellosign.FieldValidationZipCode),
+		hellosign.DropdownField("department", 0).At(10, 40).Default("Finance"),
+		hellosign.RadioField("express", 0, "shipping").At(10, 80),
+		hellosign.RadioField("regular", 0, "delivery").At(40, 80),
+	)
+
+	_, _, err := b.Build()
+
+	var validationErrors hellosign.ValidationErrors
+	is.True(errors.As(err, &validationErrors))
+
+	fields := []string{}
+	for _, validationError := range validationErrors {
+		fields = append(fields, validationError.Field)
+	}
+	is.Equal([]string{
+		"form_field_groups[0][requirement]",
+		"form_fields_per_document[0][0]",
+		"form_fields_per_document[0][1][api_id]",
+		"form_fields_per_document[0][1][validation_type]",
+		"form_fields_per_document[0][2][options]",
+		"form_fields_per_document[0][2][content]",
+		"form_fields_per_document[0][4][group]",
+		"form_field_groups[0]",
+		"form_field_groups[1]",
+	}, fields)
+}
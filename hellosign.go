@@ -16,13 +16,28 @@ const (
 
 // Client is api client for hellosign
 type Client struct {
-	common              service
-	apiKey              string
-	HTTPClient          *http.Client
-	BaseURL             string
+	common     service
+	apiKey     string
+	HTTPClient *http.Client
+	BaseURL    string
+	// DownloadTimeout is the timeout used instead of HTTPClient timeout
+	// when downloading documents, since files may be larger than a regular response
+	DownloadTimeout time.Duration
+	// RateLimiter is waited on before every request when it is set,
+	// so concurrent calls sharing the client stay under hellosign rate limits
+	RateLimiter         RateLimiter
 	AccountAPI          *AccountAPI
+	BulkSendJobAPI      *BulkSendJobAPI
+	EmbeddedAPI         *EmbeddedAPI
 	SignatureRequestAPI *SignatureRequestAPI
 	TeamAPI             *TeamAPI
+	TemplateAPI         *TemplateAPI
+}
+
+// RateLimiter limits the rate of requests, ex: *rate.Limiter of golang.org/x/time/rate
+type RateLimiter interface {
+	// Wait blocks until a request is allowed or ctx is done
+	Wait(ctx context.Context) error
 }
 
 type service struct {
@@ -46,9 +61,13 @@ func NewClient(apiKey string) *Client {
 		Timeout: 5 * time.Second,
 	}
 	c.BaseURL = baseURL
+	c.DownloadTimeout = 5 * time.Minute
 	c.AccountAPI = (*AccountAPI)(&c.common)
+	c.BulkSendJobAPI = (*BulkSendJobAPI)(&c.common)
+	c.EmbeddedAPI = (*EmbeddedAPI)(&c.common)
 	c.SignatureRequestAPI = (*SignatureRequestAPI)(&c.common)
 	c.TeamAPI = (*TeamAPI)(&c.common)
+	c.TemplateAPI = (*TemplateAPI)(&c.common)
 	return c
 }
 
@@ -86,7 +105,25 @@ func (c *Client) prepareRequest(ctx context.Context, r requestParam) (*http.Requ
 }
 
 func (c *Client) executeRequest(req *http.Request) (*http.Response, error) {
-	resp, err := c.HTTPClient.Do(req)
+	return c.doRequest(c.HTTPClient, req)
+}
+
+// downloadClient returns a copy of HTTPClient with DownloadTimeout as its timeout
+func (c *Client) downloadClient() *http.Client {
+	client := *c.HTTPClient
+	client.Timeout = c.DownloadTimeout
+	return &client
+}
+
+func (c *Client) doRequest(httpClient *http.Client, req *http.Request) (*http.Response, error) {
+	if c.RateLimiter != nil {
+		err := c.RateLimiter.Wait(req.Context())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
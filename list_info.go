@@ -1,5 +1,10 @@
 package hellosign
 
+import (
+	"net/url"
+	"strconv"
+)
+
 // ListInfo is a information for query parameter response
 type ListInfo struct {
 	Page       int `json:"page"`
@@ -13,3 +18,13 @@ type ListInfoQueryParam struct {
 	Page     int `json:"page"`
 	PageSize int `json:"page_size"`
 }
+
+// addQuery adds page and page size into query param when they are set
+func (l ListInfoQueryParam) addQuery(q url.Values) {
+	if l.Page > 0 {
+		q.Add("page", strconv.Itoa(l.Page))
+	}
+	if l.PageSize > 0 {
+		q.Add("page_size", strconv.Itoa(l.PageSize))
+	}
+}
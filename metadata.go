@@ -0,0 +1,118 @@
+package hellosign
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+// Metadata is key value data attached to a signature request.
+// Numbers are decoded as json.Number, so numeric ids don't lose precision.
+type Metadata map[string]interface{}
+
+// UnmarshalJSON decodes metadata keeping numbers as json.Number
+func (m *Metadata) UnmarshalJSON(b []byte) error {
+	metadata := map[string]interface{}{}
+	err := decodeJSONNumber(b, &metadata)
+	if err != nil {
+		return err
+	}
+	*m = metadata
+	return nil
+}
+
+// Decode decodes metadata into v, a pointer to a struct or map with json tags
+func (m Metadata) Decode(v interface{}) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return decodeJSONNumber(b, v)
+}
+
+// DecodeMetadata decodes metadata of the signature request into v,
+// a pointer to a struct or map with json tags
+func (s SignatureRequestDetail) DecodeMetadata(v interface{}) error {
+	return s.Metadata.Decode(v)
+}
+
+// SetMetadata sets metadata of the payload from v, a struct or map with json tags.
+// It returns ValidationErrors when the metadata exceeds hellosign limits.
+func (p *SignatureRequestPayload) SetMetadata(v interface{}) error {
+	metadata, err := encodeMetadata(v)
+	if err != nil {
+		return err
+	}
+	p.Metadata = metadata
+	return nil
+}
+
+// SetMetadata sets metadata of the payload from v, a struct or map with json tags.
+// It returns ValidationErrors when the metadata exceeds hellosign limits.
+func (p *SignatureRequestTemplatePayload) SetMetadata(v interface{}) error {
+	metadata, err := encodeMetadata(v)
+	if err != nil {
+		return err
+	}
+	p.Metadata = metadata
+	return nil
+}
+
+// SetMetadata sets metadata of the payload from v, a struct or map with json tags.
+// It returns ValidationErrors when the metadata exceeds hellosign limits.
+func (p *BulkSendWithTemplatePayload) SetMetadata(v interface{}) error {
+	metadata, err := encodeMetadata(v)
+	if err != nil {
+		return err
+	}
+	p.Metadata = metadata
+	return nil
+}
+
+// encodeMetadata converts v into metadata and check it against hellosign limits.
+// Values must be strings, numbers or booleans.
+func encodeMetadata(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]interface{}{}
+	err = decodeJSONNumber(b, &metadata)
+	if err != nil {
+		return nil, errors.New("hellosign: metadata must be a struct or map")
+	}
+
+	errs := ValidationErrors{}
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		switch metadata[k].(type) {
+		case string, json.Number, bool:
+		case nil:
+			delete(metadata, k)
+		default:
+			errs.add("metadata["+k+"]", "value must be a string, number or bool")
+		}
+	}
+	validateMetadata(&errs, metadata)
+
+	err = errs.err()
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// decodeJSONNumber decodes json b into v keeping numbers as json.Number
+func decodeJSONNumber(b []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
@@ -0,0 +1,95 @@
+package hellosign_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+)
+
+func TestSignatureRequestPayload_SetMetadata(t *testing.T) {
+	type employee struct {
+		EmployeeID int64  `json:"employee_id"`
+		Department string `json:"department,omitempty"`
+		Remote     bool   `json:"remote"`
+	}
+
+	tests := map[string]struct {
+		v                interface{}
+		expectedMetadata map[string]interface{}
+		expectedError    []string
+	}{
+		"struct": {
+			v: employee{EmployeeID: 9007199254740993, Remote: true},
+			expectedMetadata: map[string]interface{}{
+				"employee_id": json.Number("9007199254740993"),
+				"remote":      true,
+			},
+		},
+		"map": {
+			v:                map[string]string{"department": "finance"},
+			expectedMetadata: map[string]interface{}{"department": "finance"},
+		},
+		"nested value": {
+			v:             map[string]interface{}{"address": map[string]string{"city": "Jakarta"}},
+			expectedError: []string{"metadata[address]"},
+		},
+		"exceed limits": {
+			v: map[string]string{
+				strings.Repeat("k", 41): "value",
+				"note":                  strings.Repeat("v", 501),
+			},
+			expectedError: []string{
+				"metadata[" + strings.Repeat("k", 41) + "]",
+				"metadata[note]",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is := is.New(t)
+
+			payload := hellosign.SignatureRequestPayload{}
+			err := payload.SetMetadata(test.v)
+			if len(test.expectedError) > 0 {
+				var errs hellosign.ValidationErrors
+				is.True(errors.As(err, &errs))
+				is.Equal(len(test.expectedError), len(errs))
+				for i, field := range test.expectedError {
+					is.Equal(field, errs[i].Field)
+				}
+				return
+			}
+
+			is.NoErr(err)
+			is.Equal(test.expectedMetadata, payload.Metadata)
+		})
+	}
+
+	is := is.New(t)
+	err := (&hellosign.SignatureRequestTemplatePayload{}).SetMetadata("employee")
+	is.True(err != nil)
+}
+
+func TestSignatureRequestDetail_DecodeMetadata(t *testing.T) {
+	is := is.New(t)
+
+	detail := hellosign.SignatureRequestDetail{}
+	err := json.Unmarshal([]byte(`{"metadata": {"employee_id": 9007199254740993, "department": "finance"}}`), &detail)
+	is.NoErr(err)
+	is.Equal(json.Number("9007199254740993"), detail.Metadata["employee_id"])
+
+	var employee struct {
+		EmployeeID int64  `json:"employee_id"`
+		Department string `json:"department"`
+	}
+	err = detail.DecodeMetadata(&employee)
+	is.NoErr(err)
+	is.Equal(int64(9007199254740993), employee.EmployeeID)
+	is.Equal("finance", employee.Department)
+}
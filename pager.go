@@ -0,0 +1,180 @@
+package hellosign
+
+import (
+	"context"
+)
+
+// PageFetchFunc fetches a single page of a list endpoint.
+// It returns the items of the page and the list info of the response.
+type PageFetchFunc func(ctx context.Context, p ListInfoQueryParam) ([]interface{}, ListInfo, error)
+
+// Pager iterates items across all pages of a list endpoint.
+// Call Next until it returns false, then check Err:
+//
+//	pager := hellosign.NewPager(fetch, hellosign.ListInfoQueryParam{PageSize: 100})
+//	for pager.Next(ctx) {
+//		item := pager.Item()
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager struct {
+	// Prefetch fetches the next page in the background
+	// while the items of the current page are being consumed.
+	// The background fetch uses the ctx given to Next.
+	Prefetch bool
+
+	fetch    PageFetchFunc
+	param    ListInfoQueryParam
+	listInfo ListInfo
+	items    []interface{}
+	index    int
+	item     interface{}
+	done     bool
+	err      error
+	pending  chan pageResult
+}
+
+// pageResult is a result of fetching a single page
+type pageResult struct {
+	items    []interface{}
+	listInfo ListInfo
+	err      error
+}
+
+// NewPager return a pager which starts from p.Page, or the first page if it is not set
+func NewPager(fetch PageFetchFunc, p ListInfoQueryParam) *Pager {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+
+	return &Pager{
+		fetch: fetch,
+		param: p,
+	}
+}
+
+// Next advances the pager to the next item, fetching the next page when needed.
+// It returns false when there are no more items, an error occurred or ctx is done.
+func (p *Pager) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+
+	err := ctx.Err()
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	for p.index >= len(p.items) {
+		if p.done {
+			return false
+		}
+
+		p.loadPage(ctx)
+		if p.err != nil {
+			return false
+		}
+	}
+
+	p.item = p.items[p.index]
+	p.index++
+	return true
+}
+
+// Item returns the current item
+func (p *Pager) Item() interface{} {
+	return p.item
+}
+
+// ListInfo returns the list info of the last fetched page
+func (p *Pager) ListInfo() ListInfo {
+	return p.listInfo
+}
+
+// Err returns the error which stopped the pager, if any
+func (p *Pager) Err() error {
+	return p.err
+}
+
+// loadPage replaces the current items with the next page
+func (p *Pager) loadPage(ctx context.Context) {
+	var result pageResult
+	if p.pending != nil {
+		select {
+		case result = <-p.pending:
+		case <-ctx.Done():
+			p.err = ctx.Err()
+			return
+		}
+		p.pending = nil
+	} else {
+		result = p.fetchPage(ctx, p.param)
+	}
+
+	if result.err != nil {
+		p.err = result.err
+		return
+	}
+
+	p.items = result.items
+	p.index = 0
+	p.listInfo = result.listInfo
+	p.param.Page++
+
+	if len(result.items) == 0 || result.listInfo.Page >= result.listInfo.NumPages {
+		p.done = true
+		return
+	}
+
+	if p.Prefetch {
+		p.pending = make(chan pageResult, 1)
+		go func(pending chan<- pageResult, param ListInfoQueryParam) {
+			pending <- p.fetchPage(ctx, param)
+		}(p.pending, p.param)
+	}
+}
+
+// fetchPage calls fetch for a single page
+func (p *Pager) fetchPage(ctx context.Context, param ListInfoQueryParam) pageResult {
+	items, listInfo, err := p.fetch(ctx, param)
+	return pageResult{
+		items:    items,
+		listInfo: listInfo,
+		err:      err,
+	}
+}
+
+// SignatureRequestPager iterates signature requests across all pages
+type SignatureRequestPager struct {
+	*Pager
+}
+
+// SignatureRequest returns the current signature request
+func (p *SignatureRequestPager) SignatureRequest() SignatureRequestDetail {
+	signatureRequest, _ := p.Item().(SignatureRequestDetail)
+	return signatureRequest
+}
+
+// BulkSendJobPager iterates bulk send jobs across all pages
+type BulkSendJobPager struct {
+	*Pager
+}
+
+// BulkSendJob returns the current bulk send job
+func (p *BulkSendJobPager) BulkSendJob() BulkSendJobDetail {
+	bulkSendJob, _ := p.Item().(BulkSendJobDetail)
+	return bulkSendJob
+}
+
+// TemplatePager iterates templates across all pages
+type TemplatePager struct {
+	*Pager
+}
+
+// Template returns the current template
+func (p *TemplatePager) Template() TemplateDetail {
+	template, _ := p.Item().(TemplateDetail)
+	return template
+}
@@ -0,0 +1,179 @@
+package hellosign_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+	"github.com/milhamhidayat/go-hellosign-sdk/testdata"
+)
+
+func TestPager_Next(t *testing.T) {
+	pages := [][]interface{}{
+		{1, 2},
+		{3, 4},
+		{5},
+	}
+
+	fetch := func(ctx context.Context, p hellosign.ListInfoQueryParam) ([]interface{}, hellosign.ListInfo, error) {
+		if p.Page > len(pages) {
+			return nil, hellosign.ListInfo{}, errors.New("page out of range")
+		}
+
+		return pages[p.Page-1], hellosign.ListInfo{
+			Page:       p.Page,
+			NumPages:   len(pages),
+			NumResults: 5,
+			PageSize:   2,
+		}, nil
+	}
+
+	tests := map[string]struct {
+		param         hellosign.ListInfoQueryParam
+		prefetch      bool
+		fetch         hellosign.PageFetchFunc
+		expectedItems []interface{}
+		expectedError error
+	}{
+		"all pages": {
+			param:         hellosign.ListInfoQueryParam{PageSize: 2},
+			fetch:         fetch,
+			expectedItems: []interface{}{1, 2, 3, 4, 5},
+		},
+		"all pages with prefetch": {
+			param:         hellosign.ListInfoQueryParam{PageSize: 2},
+			prefetch:      true,
+			fetch:         fetch,
+			expectedItems: []interface{}{1, 2, 3, 4, 5},
+		},
+		"start from second page": {
+			param:         hellosign.ListInfoQueryParam{Page: 2, PageSize: 2},
+			fetch:         fetch,
+			expectedItems: []interface{}{3, 4, 5},
+		},
+		"error on second page": {
+			param:    hellosign.ListInfoQueryParam{PageSize: 2},
+			prefetch: true,
+			fetch: func(ctx context.Context, p hellosign.ListInfoQueryParam) ([]interface{}, hellosign.ListInfo, error) {
+				if p.Page == 2 {
+					return nil, hellosign.ListInfo{}, errors.New("unknown: Unknown error")
+				}
+				return fetch(ctx, p)
+			},
+			expectedItems: []interface{}{1, 2},
+			expectedError: errors.New("unknown: Unknown error"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+
+			pager := hellosign.NewPager(test.fetch, test.param)
+			pager.Prefetch = test.prefetch
+
+			items := []interface{}{}
+			for pager.Next(context.TODO()) {
+				items = append(items, pager.Item())
+			}
+
+			is.Equal(test.expectedItems, items)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), pager.Err().Error())
+				return
+			}
+			is.NoErr(pager.Err())
+		})
+	}
+}
+
+func TestPager_NextContextCanceled(t *testing.T) {
+	tests := map[string]struct {
+		itemsPerPage int
+	}{
+		"single item per page": {
+			itemsPerPage: 1,
+		},
+		"several items per page": {
+			itemsPerPage: 5,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+
+			fetch := func(ctx context.Context, p hellosign.ListInfoQueryParam) ([]interface{}, hellosign.ListInfo, error) {
+				items := []interface{}{}
+				for i := 0; i < test.itemsPerPage; i++ {
+					items = append(items, p.Page)
+				}
+				return items, hellosign.ListInfo{Page: p.Page, NumPages: 10}, nil
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			pager := hellosign.NewPager(fetch, hellosign.ListInfoQueryParam{})
+
+			is.True(pager.Next(ctx))
+			is.Equal(1, pager.Item())
+
+			cancel()
+			is.True(!pager.Next(ctx))
+			is.Equal(context.Canceled, pager.Err())
+			is.True(!pager.Next(ctx))
+		})
+	}
+}
+
+func TestSignatureRequest_FetchAll(t *testing.T) {
+	is := is.New(t)
+
+	signatureRequestListJSON := testdata.GetGolden(t, "signature-request-list")
+	signatureRequestList := hellosign.SignatureRequestList{}
+	err := json.Unmarshal(signatureRequestListJSON, &signatureRequestList)
+	is.NoErr(err)
+
+	signatureRequestList.ListInfo.NumPages = 2
+	firstPageJSON, err := json.Marshal(signatureRequestList)
+	is.NoErr(err)
+
+	signatureRequestList.ListInfo.Page = 2
+	secondPageJSON, err := json.Marshal(signatureRequestList)
+	is.NoErr(err)
+
+	responses := map[string][]byte{
+		"1": firstPageJSON,
+		"2": secondPageJSON,
+	}
+
+	mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(responses[req.URL.Query().Get("page")])),
+			Header:     make(http.Header),
+		}
+	})
+
+	apiClient := hellosign.NewClient("123")
+	apiClient.HTTPClient = mockHTTPClient
+
+	pager := apiClient.SignatureRequestAPI.FetchAll(hellosign.SignatureRequestListParam{
+		ListInfoQueryParam: hellosign.ListInfoQueryParam{PageSize: 2},
+	})
+
+	signatureRequestIDs := []string{}
+	for pager.Next(context.TODO()) {
+		signatureRequestIDs = append(signatureRequestIDs, pager.SignatureRequest().SignatureRequestID)
+	}
+	is.NoErr(pager.Err())
+	is.Equal(4, len(signatureRequestIDs))
+	is.Equal(signatureRequestList.SignatureRequests[0].SignatureRequestID, signatureRequestIDs[0])
+	is.Equal(signatureRequestList.SignatureRequests[1].SignatureRequestID, signatureRequestIDs[3])
+}
@@ -0,0 +1,91 @@
+package hellosign
+
+import (
+	"sort"
+	"strings"
+)
+
+// PayloadOption overrides values of a payload built by PayloadFromDetail
+type PayloadOption func(p *SignatureRequestPayload)
+
+// PayloadFromDetail rebuilds a send ready payload from a fetched signature request.
+// It copies title, subject, message, signing redirect url, signers sorted by order,
+// cc email addresses, metadata and custom fields, then applies opts in order.
+// Documents are not copied, pass them again as FileURL or files when sending.
+func PayloadFromDetail(detail SignatureRequestDetail, opts ...PayloadOption) SignatureRequestPayload {
+	p := SignatureRequestPayload{
+		Title:              detail.Title,
+		Subject:            detail.Subject,
+		Message:            detail.Message,
+		SigningRedirectURL: detail.SigningRedirectURL,
+	}
+	if detail.TestMode {
+		p.TestMode = 1
+	}
+
+	signatures := make([]SignatureDetail, len(detail.Signatures))
+	copy(signatures, detail.Signatures)
+	sort.SliceStable(signatures, func(i, j int) bool {
+		return signatures[i].Order < signatures[j].Order
+	})
+
+	ordered := false
+	for _, signature := range signatures {
+		if signature.Order != 0 {
+			ordered = true
+			break
+		}
+	}
+
+	for i, signature := range signatures {
+		signer := SignerDetail{
+			Name:         signature.SignerName,
+			EmailAddress: signature.SignerEmailAddress,
+		}
+		if ordered {
+			signer.Order = i
+		}
+		p.Signers = append(p.Signers, signer)
+	}
+
+	if len(detail.CCEmailAddresses) > 0 {
+		p.CCEmailAddresses = append([]string{}, detail.CCEmailAddresses...)
+	}
+
+	if len(detail.Metadata) > 0 {
+		p.Metadata = make(map[string]interface{}, len(detail.Metadata))
+		for k, v := range detail.Metadata {
+			p.Metadata[k] = v
+		}
+	}
+
+	if len(detail.CustomFields) > 0 {
+		p.CustomFields = append([]CustomFieldsDetail{}, detail.CustomFields...)
+	}
+
+	for _, opt := range opts {
+		opt(&p)
+	}
+
+	return p
+}
+
+// WithSigners replaces all signers of the payload
+func WithSigners(signers ...SignerDetail) PayloadOption {
+	return func(p *SignatureRequestPayload) {
+		p.Signers = signers
+	}
+}
+
+// ReplaceSigner replaces the signer with emailAddress, compared case insensitively,
+// keeping its position and order
+func ReplaceSigner(emailAddress string, signer SignerDetail) PayloadOption {
+	return func(p *SignatureRequestPayload) {
+		for i := range p.Signers {
+			if strings.EqualFold(p.Signers[i].EmailAddress, emailAddress) {
+				signer.Order = p.Signers[i].Order
+				p.Signers[i] = signer
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package hellosign_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+)
+
+func TestPayloadFromDetail(t *testing.T) {
+	detail := hellosign.SignatureRequestDetail{
+		TestMode:           true,
+		SignatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+		Title:              "Purchase Agreement",
+		Subject:            "Purchase Agreement",
+		Message:            "Please sign and return.",
+		SigningRedirectURL: "https://example.com/signed",
+		CCEmailAddresses:   []string{"accounting@example.com"},
+		CustomFields: []hellosign.CustomFieldsDetail{
+			{Name: "Cost", FieldType: hellosign.FieldText, Value: "$20,000", Editor: "Client", Required: true},
+		},
+		Metadata: hellosign.Metadata{"employee_id": json.Number("1234")},
+		Signatures: []hellosign.SignatureDetail{
+			{SignerName: "Jane Doe", SignerEmailAddress: "jane@example.com", Order: 2},
+			{SignerName: "John Doe", SignerEmailAddress: "jhon@example.com", Order: 1},
+			{SignerName: "Jack Doe", SignerEmailAddress: "jack@example.com", Order: 3},
+		},
+	}
+
+	expectedPayload := hellosign.SignatureRequestPayload{
+		TestMode:           1,
+		Title:              "Purchase Agreement",
+		Subject:            "Purchase Agreement",
+		Message:            "Please sign and return.",
+		SigningRedirectURL: "https://example.com/signed",
+		CCEmailAddresses:   []string{"accounting@example.com"},
+		CustomFields: []hellosign.CustomFieldsDetail{
+			{Name: "Cost", FieldType: hellosign.FieldText, Value: "$20,000", Editor: "Client", Required: true},
+		},
+		Metadata: map[string]interface{}{"employee_id": json.Number("1234")},
+		Signers: []hellosign.SignerDetail{
+			{Name: "John Doe", EmailAddress: "jhon@example.com", Order: 0},
+			{Name: "Jane Doe", EmailAddress: "jane@example.com", Order: 1},
+			{Name: "Jack Doe", EmailAddress: "jack@example.com", Order: 2},
+		},
+	}
+
+	tests := map[string]struct {
+		opts            []hellosign.PayloadOption
+		expectedPayload func() hellosign.SignatureRequestPayload
+	}{
+		"copy": {
+			expectedPayload: func() hellosign.SignatureRequestPayload {
+				return expectedPayload
+			},
+		},
+		"replace signer": {
+			opts: []hellosign.PayloadOption{
+				hellosign.ReplaceSigner("JHON@example.com", hellosign.SignerDetail{Name: "John Doe", EmailAddress: "john@example.com"}),
+				func(p *hellosign.SignatureRequestPayload) {
+					p.Title = "Purchase Agreement (corrected)"
+				},
+			},
+			expectedPayload: func() hellosign.SignatureRequestPayload {
+				p := expectedPayload
+				p.Title = "Purchase Agreement (corrected)"
+				p.Signers = []hellosign.SignerDetail{
+					{Name: "John Doe", EmailAddress: "john@example.com", Order: 0},
+					{Name: "Jane Doe", EmailAddress: "jane@example.com", Order: 1},
+					{Name: "Jack Doe", EmailAddress: "jack@example.com", Order: 2},
+				}
+				return p
+			},
+		},
+		"with signers": {
+			opts: []hellosign.PayloadOption{
+				hellosign.WithSigners(hellosign.SignerDetail{Name: "Jill Doe", EmailAddress: "jill@example.com"}),
+			},
+			expectedPayload: func() hellosign.SignatureRequestPayload {
+				p := expectedPayload
+				p.Signers = []hellosign.SignerDetail{
+					{Name: "Jill Doe", EmailAddress: "jill@example.com"},
+				}
+				return p
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			is := is.New(t)
+
+			payload := hellosign.PayloadFromDetail(detail, test.opts...)
+			is.Equal(test.expectedPayload(), payload)
+
+			payload.Metadata["employee_id"] = "changed"
+			is.Equal(json.Number("1234"), detail.Metadata["employee_id"])
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package hellosign
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// structTag is the struct tag used to map a struct field to a hellosign field,
+	// ex: `hellosign:"employee_id,required"`
+	structTag = "hellosign"
+
+	// structTagRequired is the struct tag option for a required field
+	structTagRequired = "required"
+
+	// structTagEditor is the struct tag option for the signer role who may edit a custom field,
+	// ex: `hellosign:"cost,editor=Client"`
+	structTagEditor = "editor="
+)
+
+// timeType is reflect type of time.Time
+var timeType = reflect.TypeOf(time.Time{})
+
+// structTagField is a struct field tagged with hellosign struct tag
+type structTagField struct {
+	index    int
+	name     string
+	required bool
+	editor   string
+}
+
+// structTagFields returns fields of struct type t which have hellosign struct tag
+func structTagFields(t reflect.Type) []structTagField {
+	fields := []structTagField{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup(structTag)
+		if !ok || tag == "-" || f.PkgPath != "" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		field := structTagField{index: i, name: parts[0]}
+		if field.name == "" {
+			field.name = f.Name
+		}
+		for _, option := range parts[1:] {
+			if option == structTagRequired {
+				field.required = true
+			}
+			if strings.HasPrefix(option, structTagEditor) {
+				field.editor = strings.TrimPrefix(option, structTagEditor)
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// DecodeResponses decodes response data into fields of v tagged with `hellosign:"api_id"`,
+// a response is matched by its api id first and then by its name.
+// Checkbox values are decoded into bool, text values into string or numbers
+// and date values into time.Time using dateFormat.
+// Hellosign does not return the date format of a request, so pass the one it was sent with,
+// ex: payload.FieldOptions.DateFormat. dateFormat may be empty only when v has no time.Time field.
+// Fields tagged with `hellosign:"api_id,required"` must have a non empty response.
+// It returns ValidationErrors which contains every missing or invalid response.
+func (s SignatureRequestDetail) DecodeResponses(v interface{}, dateFormat DateFormat) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("hellosign: decode responses requires a non nil pointer to struct")
+	}
+	rv = rv.Elem()
+
+	fields := structTagFields(rv.Type())
+	if dateFormat != "" {
+		err := dateFormat.Validate()
+		if err != nil {
+			return err
+		}
+	} else if hasTimeField(rv.Type(), fields) {
+		return errors.New("hellosign: decode responses requires the date format of the request for time.Time fields")
+	}
+
+	errs := ValidationErrors{}
+	for _, field := range fields {
+		path := "response_data[" + field.name + "]"
+
+		value, ok := s.responseValue(field.name)
+		if !ok || value == nil || value == "" {
+			if field.required {
+				errs.add(path, "is required")
+			}
+			continue
+		}
+
+		err := setResponseValue(rv.Field(field.index), value, dateFormat)
+		if err != nil {
+			errs.add(path, "%v", err)
+		}
+	}
+
+	return errs.err()
+}
+
+// hasTimeField check if any of fields of struct type t is time.Time or *time.Time
+func hasTimeField(t reflect.Type, fields []structTagField) bool {
+	for _, field := range fields {
+		fieldType := t.Field(field.index).Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType == timeType {
+			return true
+		}
+	}
+	return false
+}
+
+// responseValue returns the first non nil value of responses which api id is key,
+// or which name is key when there is no response with that api id
+func (s SignatureRequestDetail) responseValue(key string) (interface{}, bool) {
+	value, found := s.findResponseValue(func(r ResponseDataDetail) bool { return r.APIID == key })
+	if found {
+		return value, true
+	}
+	return s.findResponseValue(func(r ResponseDataDetail) bool { return r.Name == key })
+}
+
+// findResponseValue returns the first non nil value of responses which match
+func (s SignatureRequestDetail) findResponseValue(match func(ResponseDataDetail) bool) (interface{}, bool) {
+	found := false
+	for _, response := range s.ResponseData {
+		if !match(response) {
+			continue
+		}
+		if response.Value != nil {
+			return response.Value, true
+		}
+		found = true
+	}
+	return nil, found
+}
+
+// setResponseValue converts a decoded json response value into the type of field
+func setResponseValue(field reflect.Value, value interface{}, dateFormat DateFormat) error {
+	if field.Kind() == reflect.Ptr {
+		ptr := reflect.New(field.Type().Elem())
+		err := setResponseValue(ptr.Elem(), value, dateFormat)
+		if err != nil {
+			return err
+		}
+		field.Set(ptr)
+		return nil
+	}
+
+	if field.Type() == timeType {
+		t, err := dateFormat.Parse(fmt.Sprint(value))
+		if err != nil {
+			return fmt.Errorf("cannot parse %v as %s", value, dateFormat)
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		switch val := value.(type) {
+		case float64:
+			field.SetString(strconv.FormatFloat(val, 'f', -1, 64))
+		default:
+			field.SetString(fmt.Sprint(val))
+		}
+	case reflect.Bool:
+		switch val := value.(type) {
+		case bool:
+			field.SetBool(val)
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(val))
+			if err != nil {
+				return fmt.Errorf("cannot convert %q to bool", val)
+			}
+			field.SetBool(b)
+		default:
+			return fmt.Errorf("cannot convert %v to bool", value)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(responseNumber(value), 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("cannot convert %v to %s", value, field.Type())
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(responseNumber(value), 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("cannot convert %v to %s", value, field.Type())
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(responseNumber(value), field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("cannot convert %v to %s", value, field.Type())
+		}
+		field.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
+
+// responseNumber returns a response value as number string, ex: " 1,250 " is "1250"
+func responseNumber(value interface{}) string {
+	switch val := value.(type) {
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case string:
+		return strings.ReplaceAll(strings.TrimSpace(val), ",", "")
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,86 @@
+package hellosign_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+	"github.com/milhamhidayat/go-hellosign-sdk/testdata"
+)
+
+func TestSignatureRequestDetail_DecodeResponses(t *testing.T) {
+	is := is.New(t)
+
+	signatureRequest := hellosign.SignatureRequest{}
+	err := json.Unmarshal(testdata.GetGolden(t, "signature-request"), &signatureRequest)
+	is.NoErr(err)
+
+	detail := signatureRequest.SignatureRequest
+	detail.ResponseData = append(detail.ResponseData,
+		hellosign.ResponseDataDetail{APIID: "80c678_4", Name: "Employee ID", Value: "1,250", FieldType: hellosign.FieldText},
+		hellosign.ResponseDataDetail{APIID: "80c678_5", Name: "Hours", Value: 37.5, FieldType: hellosign.FieldText},
+	)
+
+	type shipping struct {
+		ExpressShipping bool      `hellosign:"80c678_1"`
+		Address         string    `hellosign:"80c678_2,required"`
+		Date            time.Time `hellosign:"DateSigned"`
+		EmployeeID      int       `hellosign:"Employee ID"`
+		Hours           *float64  `hellosign:"80c678_5"`
+		Note            string    `hellosign:"note"`
+		Ignored         string
+	}
+
+	result := shipping{}
+	err = detail.DecodeResponses(&result, "")
+	is.Equal("hellosign: decode responses requires the date format of the request for time.Time fields", err.Error())
+
+	err = detail.DecodeResponses(&result, "MM/DD/YYYY")
+	is.Equal(`hellosign: unknown date format "MM/DD/YYYY"`, err.Error())
+
+	err = detail.DecodeResponses(&result, hellosign.DateFormat1)
+	is.NoErr(err)
+
+	hours := 37.5
+	is.Equal(shipping{
+		ExpressShipping: true,
+		Address:         "1212 Park Avenue",
+		Date:            time.Date(2012, time.September, 1, 0, 0, 0, 0, time.UTC),
+		EmployeeID:      1250,
+		Hours:           &hours,
+	}, result)
+}
+
+func TestSignatureRequestDetail_DecodeResponsesInvalid(t *testing.T) {
+	is := is.New(t)
+
+	detail := hellosign.SignatureRequestDetail{
+		ResponseData: []hellosign.ResponseDataDetail{
+			{APIID: "employee_id", Value: "E-1250", FieldType: hellosign.FieldText},
+			{APIID: "agree", Value: nil, FieldType: hellosign.FieldCheckBox},
+		},
+	}
+
+	type employee struct {
+		EmployeeID int    `hellosign:"employee_id"`
+		Agree      bool   `hellosign:"agree,required"`
+		Department string `hellosign:"department,required"`
+	}
+
+	err := detail.DecodeResponses(&employee{}, "")
+
+	var validationErrors hellosign.ValidationErrors
+	is.True(errors.As(err, &validationErrors))
+	is.Equal(hellosign.ValidationErrors{
+		{Field: "response_data[employee_id]", Message: "cannot convert E-1250 to int"},
+		{Field: "response_data[agree]", Message: "is required"},
+		{Field: "response_data[department]", Message: "is required"},
+	}, validationErrors)
+
+	err = detail.DecodeResponses(employee{}, "")
+	is.Equal("hellosign: decode responses requires a non nil pointer to struct", err.Error())
+}
@@ -0,0 +1,108 @@
+package hellosign
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// searchDateLayout is date layout used by hellosign search
+	searchDateLayout = "2006-01-02"
+)
+
+// SearchQuery is a builder for hellosign search query, used in SignatureRequestListParam.Query.
+// All conditions are combined with AND, ex:
+//
+//	hellosign.Query().Title("NDA").Signer("a@b.com").Complete(false).String()
+//
+// Ref: https://app.hellosign.com/api/reference#Search
+type SearchQuery struct {
+	terms    []string
+	from     time.Time
+	to       time.Time
+	complete *bool
+}
+
+// Query returns a new empty search query
+func Query() *SearchQuery {
+	return &SearchQuery{}
+}
+
+// Term adds a free text search term
+func (q *SearchQuery) Term(term string) *SearchQuery {
+	if term != "" {
+		q.terms = append(q.terms, quoteSearchValue(term))
+	}
+	return q
+}
+
+// Title filters signature requests by title
+func (q *SearchQuery) Title(title string) *SearchQuery {
+	return q.field("title", title)
+}
+
+// Signer filters signature requests by signer email address or name
+func (q *SearchQuery) Signer(signer string) *SearchQuery {
+	return q.field("to", signer)
+}
+
+// Requester filters signature requests by requester email address
+func (q *SearchQuery) Requester(emailAddress string) *SearchQuery {
+	return q.field("from", emailAddress)
+}
+
+// From filters signature requests created on or after t
+func (q *SearchQuery) From(t time.Time) *SearchQuery {
+	q.from = t
+	return q
+}
+
+// To filters signature requests created on or before t
+func (q *SearchQuery) To(t time.Time) *SearchQuery {
+	q.to = t
+	return q
+}
+
+// Complete filters signature requests by whether all signers have signed
+func (q *SearchQuery) Complete(complete bool) *SearchQuery {
+	q.complete = &complete
+	return q
+}
+
+// String returns the search query to be used as SignatureRequestListParam.Query
+func (q *SearchQuery) String() string {
+	terms := append([]string{}, q.terms...)
+
+	if !q.from.IsZero() || !q.to.IsZero() {
+		from, to := "*", "*"
+		if !q.from.IsZero() {
+			from = q.from.Format(searchDateLayout)
+		}
+		if !q.to.IsZero() {
+			to = q.to.Format(searchDateLayout)
+		}
+		terms = append(terms, "created:["+from+" TO "+to+"]")
+	}
+
+	if q.complete != nil {
+		terms = append(terms, "complete:"+strconv.FormatBool(*q.complete))
+	}
+
+	return strings.Join(terms, " AND ")
+}
+
+// field adds a search term for a field, empty value is ignored
+func (q *SearchQuery) field(name, value string) *SearchQuery {
+	if value != "" {
+		q.terms = append(q.terms, name+":"+quoteSearchValue(value))
+	}
+	return q
+}
+
+// quoteSearchValue quotes value and escapes backslash and double quote in it
+func quoteSearchValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return `"` + value + `"`
+}
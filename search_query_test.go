@@ -0,0 +1,48 @@
+package hellosign_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+)
+
+func TestSearchQuery_String(t *testing.T) {
+	from := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		query         *hellosign.SearchQuery
+		expectedQuery string
+	}{
+		"empty": {
+			query:         hellosign.Query(),
+			expectedQuery: "",
+		},
+		"all fields": {
+			query:         hellosign.Query().Title("NDA").Signer("a@b.com").From(from).To(to).Complete(false),
+			expectedQuery: `title:"NDA" AND to:"a@b.com" AND created:[2020-01-01 TO 2020-12-31] AND complete:false`,
+		},
+		"open date range": {
+			query:         hellosign.Query().From(from),
+			expectedQuery: `created:[2020-01-01 TO *]`,
+		},
+		"escape value": {
+			query:         hellosign.Query().Title(`Lease "Unit 4" C:\docs`).Requester("me@hellosign.com"),
+			expectedQuery: `title:"Lease \"Unit 4\" C:\\docs" AND from:"me@hellosign.com"`,
+		},
+		"ignore empty value": {
+			query:         hellosign.Query().Title("").Term("purchase agreement").Complete(true),
+			expectedQuery: `"purchase agreement" AND complete:true`,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			is.Equal(test.expectedQuery, test.query.String())
+		})
+	}
+}
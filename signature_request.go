@@ -3,14 +3,64 @@ package hellosign
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"sort"
 	"strconv"
+	"sync"
+	"time"
 )
 
 const (
 	subURLSignatureRequest = "/signature_request"
 )
 
+var (
+	// subURLSignatureRequestSend is sub url path for send a signature request
+	subURLSignatureRequestSend = subURLSignatureRequest + "/send"
+
+	// subURLSignatureRequestSendWithTemplate is sub url path for send a signature request based on templates
+	subURLSignatureRequestSendWithTemplate = subURLSignatureRequest + "/send_with_template"
+
+	// subURLSignatureRequestCancel is sub url path for cancel an incomplete signature request
+	subURLSignatureRequestCancel = subURLSignatureRequest + "/cancel"
+
+	// subURLSignatureRequestRemove is sub url path for remove access to a completed signature request
+	subURLSignatureRequestRemove = subURLSignatureRequest + "/remove"
+
+	// subURLSignatureRequestRemind is sub url path for send a reminder to a signer
+	subURLSignatureRequestRemind = subURLSignatureRequest + "/remind"
+
+	// subURLSignatureRequestFiles is sub url path for download signature request documents
+	subURLSignatureRequestFiles = subURLSignatureRequest + "/files"
+
+	// subURLSignatureRequestCreateEmbedded is sub url path for create an embedded signature request
+	subURLSignatureRequestCreateEmbedded = subURLSignatureRequest + "/create_embedded"
+
+	// subURLSignatureRequestCreateEmbeddedWithTemplate is sub url path for create an embedded signature request based on templates
+	subURLSignatureRequestCreateEmbeddedWithTemplate = subURLSignatureRequest + "/create_embedded_with_template"
+
+	// subURLSignatureRequestReleaseHold is sub url path for release a signature request on hold
+	subURLSignatureRequestReleaseHold = subURLSignatureRequest + "/release_hold"
+
+	// subURLSignatureRequestEdit is sub url path for edit and resend a signature request
+	subURLSignatureRequestEdit = subURLSignatureRequest + "/edit"
+
+	// subURLSignatureRequestEditEmbedded is sub url path for edit and resend an embedded signature request
+	subURLSignatureRequestEditEmbedded = subURLSignatureRequest + "/edit_embedded"
+
+	// subURLSignatureRequestEditWithTemplate is sub url path for edit and resend a signature request based on templates
+	subURLSignatureRequestEditWithTemplate = subURLSignatureRequest + "/edit_with_template"
+
+	// subURLSignatureRequestUpdate is sub url path for update a signer of a signature request
+	subURLSignatureRequestUpdate = subURLSignatureRequest + "/update"
+
+	// subURLSignatureRequestBulkSendWithTemplate is sub url path for bulk send signature requests based on templates
+	subURLSignatureRequestBulkSendWithTemplate = subURLSignatureRequest + "/bulk_send_with_template"
+)
+
 // SignatureRequestAPI is a service to signature request API
 type SignatureRequestAPI service
 
@@ -18,13 +68,14 @@ type SignatureRequestAPI service
 type SignatureRequestListParam struct {
 	ListInfoQueryParam
 	AccountID string
-	Query     string
+	// Query is hellosign search query, use Query() to build it
+	Query string
 }
 
 // SignatureRequestList is a response for fetch signature requests
 type SignatureRequestList struct {
-	ListInfo          ListInfo           `json:"list_info"`
-	SignatureRequests []SignatureRequest `json:"signature_requests"`
+	ListInfo          ListInfo                 `json:"list_info"`
+	SignatureRequests []SignatureRequestDetail `json:"signature_requests"`
 }
 
 // SignatureRequest is a response for signature request
@@ -33,29 +84,36 @@ type SignatureRequest struct {
 	Warnings         []Warnings             `json:"warnings,omitempty"`
 }
 
+// CheckWarnings check if there are warning messages
+func (s SignatureRequest) CheckWarnings() bool {
+	return len(s.Warnings) > 0
+}
+
 // SignatureRequestDetail is a detail for signature request
 type SignatureRequestDetail struct {
-	TestMode              bool                   `json:"test_mode"`
-	SignatureRequestID    string                 `json:"signature_request_id"`
-	RequesterEmailAddress string                 `json:"requester_email_address"`
-	Title                 string                 `json:"title"`
-	OriginalTitle         string                 `json:"original_title"`
-	Subject               string                 `json:"subject"`
-	Message               string                 `json:"message"`
-	CreatedAt             int64                  `json:"created_at"`
-	IsComplete            bool                   `json:"is_complete"`
-	IsDeclined            bool                   `json:"is_declined"`
-	HasError              bool                   `json:"has_error"`
-	FilesURL              string                 `json:"has_url"`
-	SigningURL            string                 `json:"signing_url"`
-	DetailsURL            string                 `json:"details_url"`
-	CCEmailAddresses      []string               `json:"cc_email_addresses"`
-	SigningRedirectURL    string                 `json:"signing_redirect_url"`
-	CustomFields          []CustomFieldsDetail   `json:"custom_fields"`
-	ResponseData          []ResponseDataDetail   `json:"response_data"`
-	Signatures            []SignatureDetail      `json:"signatures"`
-	Metadata              map[string]interface{} `json:"metadata"`
-	TemplateIDS           string                 `json:"template_ids"`
+	TestMode              bool                 `json:"test_mode"`
+	SignatureRequestID    string               `json:"signature_request_id"`
+	RequesterEmailAddress string               `json:"requester_email_address"`
+	Title                 string               `json:"title"`
+	OriginalTitle         string               `json:"original_title"`
+	Subject               string               `json:"subject"`
+	Message               string               `json:"message"`
+	CreatedAt             UnixTime             `json:"created_at"`
+	IsComplete            bool                 `json:"is_complete"`
+	IsDeclined            bool                 `json:"is_declined"`
+	HasError              bool                 `json:"has_error"`
+	FilesURL              string               `json:"files_url"`
+	SigningURL            string               `json:"signing_url"`
+	DetailsURL            string               `json:"details_url"`
+	CCEmailAddresses      []string             `json:"cc_email_addresses"`
+	SigningRedirectURL    string               `json:"signing_redirect_url"`
+	CustomFields          []CustomFieldsDetail `json:"custom_fields"`
+	ResponseData          []ResponseDataDetail `json:"response_data"`
+	Signatures            []SignatureDetail    `json:"signatures"`
+	Metadata              Metadata             `json:"metadata"`
+	ExpiresAt             UnixTime             `json:"expires_at"`
+	TemplateIDS           string               `json:"template_ids"`
+	BulkSendJobID         string               `json:"bulk_send_job_id"`
 }
 
 // CustomFieldsDetail is details for custom fields
@@ -80,20 +138,40 @@ type ResponseDataDetail struct {
 
 // SignatureDetail is detail for signature
 type SignatureDetail struct {
-	SignatureID        string `json:"signature_id"`
-	SignerEmailAddress string `json:"signer_email_address"`
-	SignerName         string `json:"signer_name"`
-	SignerRole         string `json:"signer_role"`
-	Order              int    `json:"order"`
-	StatusCode         string `json:"status_code"`
-	DeclineReason      string `json:"decline_reason"`
-	SignedAt           int64  `json:"signed_at"`
-	LastViewedAt       int64  `json:"last_viewed_at"`
-	LastRemindedAt     int64  `json:"last_reminded_at"`
-	HasPin             bool   `json:"has_pin"`
-	ReassignedBy       string `json:"reassigned_by"`
-	ReassignmentReason string `json:"reassignment_reason"`
-	Error              string `json:"error"`
+	SignatureID        string       `json:"signature_id"`
+	SignerEmailAddress string       `json:"signer_email_address"`
+	SignerName         string       `json:"signer_name"`
+	SignerRole         string       `json:"signer_role"`
+	Order              int          `json:"order"`
+	StatusCode         SignerStatus `json:"status_code"`
+	DeclineReason      string       `json:"decline_reason"`
+	SignedAt           UnixTime     `json:"signed_at"`
+	LastViewedAt       UnixTime     `json:"last_viewed_at"`
+	LastRemindedAt     UnixTime     `json:"last_reminded_at"`
+	HasPin             bool         `json:"has_pin"`
+	ReassignedBy       string       `json:"reassigned_by"`
+	ReassignmentReason string       `json:"reassignment_reason"`
+	Error              string       `json:"error"`
+}
+
+// TimeToSign returns the duration from requestedAt until the signer signed.
+// It returns false if the signer has not signed yet or requestedAt is not set.
+func (s SignatureDetail) TimeToSign(requestedAt UnixTime) (time.Duration, bool) {
+	if s.SignedAt.IsZero() || requestedAt.IsZero() {
+		return 0, false
+	}
+	return s.SignedAt.Time().Sub(requestedAt.Time()), true
+}
+
+// TimeToSign returns the duration from the request creation until the signer
+// with signatureID signed. It returns false if the signer is not found or has not signed yet.
+func (s SignatureRequestDetail) TimeToSign(signatureID string) (time.Duration, bool) {
+	for _, signature := range s.Signatures {
+		if signature.SignatureID == signatureID {
+			return signature.TimeToSign(s.CreatedAt)
+		}
+	}
+	return 0, false
 }
 
 // SignatureRequestPayload is payload for signature request
@@ -115,20 +193,66 @@ type SignatureRequestPayload struct {
 	AllowDecline          int                         `json:"allow_decline"`
 	AllowReassign         int                         `json:"allow_reassign"`
 	FormFieldsPerDocument [][]FormFieldDetail         `json:"form_fields_per_document"`
-	SigningOptions        map[string]interface{}      `json:"signing_options"`
+	FormFieldGroups       []FormFieldGroupDetail      `json:"form_field_groups"`
+	SigningOptions        *SigningOptions             `json:"signing_options"`
 	FieldOptions          FieldOptionsDetail          `json:"field_options"`
+	ExpiresAt             UnixTime                    `json:"expires_at"`
+}
+
+// SignatureRequestTemplatePayload is payload for signature request based on one or more templates.
+// Signers and CCs are keyed by the role name defined in the template.
+type SignatureRequestTemplatePayload struct {
+	TestMode           int                             `json:"test_mode"`
+	TemplateIDs        []string                        `json:"template_ids"`
+	Title              string                          `json:"title"`
+	Subject            string                          `json:"subject"`
+	Message            string                          `json:"message"`
+	SigningRedirectURL string                          `json:"signing_redirect_url"`
+	Signers            map[string]TemplateSignerDetail `json:"signers"`
+	CCs                map[string]string               `json:"ccs"`
+	CustomFields       []CustomFieldsDetail            `json:"custom_fields"`
+	Metadata           map[string]interface{}          `json:"metadata"`
+	ClientID           string                          `json:"client_id"`
+	AllowDecline       int                             `json:"allow_decline"`
+	ExpiresAt          UnixTime                        `json:"expires_at"`
+}
+
+// TemplateSignerDetail is detail for signer of a template role
+type TemplateSignerDetail struct {
+	Name               string             `json:"name"`
+	EmailAddress       string             `json:"email_address"`
+	Pin                string             `json:"pin,omitempty"`
+	SMSPhoneNumber     string             `json:"sms_phone_number,omitempty"`
+	SMSPhoneNumberType SMSPhoneNumberType `json:"sms_phone_number_type,omitempty"`
 }
 
 // SignerDetail is detail for signer
+// Pin is an access code of 4 to 12 characters the signer must enter to view the document.
+// SMSPhoneNumber is in E.164 format, ex: +14155550100, used as SMSPhoneNumberType.
+// When Group is set, any one of Members may sign on behalf of the group
+// and Name and EmailAddress are not used.
 type SignerDetail struct {
-	Name         string              `json:"name"`
-	EmailAddress string              `json:"email_address"`
-	Order        int                 `json:"int"`
-	Pin          int                 `json:"pin"`
-	Group        []SignerGroupDetail `json:"signers"`
+	Name               string              `json:"name"`
+	EmailAddress       string              `json:"email_address"`
+	Order              int                 `json:"order"`
+	Pin                string              `json:"pin"`
+	SMSPhoneNumber     string              `json:"sms_phone_number"`
+	SMSPhoneNumberType SMSPhoneNumberType  `json:"sms_phone_number_type"`
+	Group              string              `json:"group"`
+	Members            []SignerGroupDetail `json:"members"`
 }
 
-// SignerGroupDetail is detail for signer group
+// SMSPhoneNumberType is how the signer sms phone number is used
+type SMSPhoneNumberType string
+
+const (
+	// SMSPhoneNumberTypeAuthentication requires the signer to enter a code sent by sms before signing
+	SMSPhoneNumberTypeAuthentication SMSPhoneNumberType = "authentication"
+	// SMSPhoneNumberTypeDelivery sends the signature request to the signer by sms
+	SMSPhoneNumberTypeDelivery SMSPhoneNumberType = "delivery"
+)
+
+// SignerGroupDetail is detail for a member of signer group
 type SignerGroupDetail struct {
 	Name         string `json:"name"`
 	EmailAddress string `json:"email_address"`
@@ -136,29 +260,42 @@ type SignerGroupDetail struct {
 
 // SignatureAttachmentDetail is detail for signature attachment
 type SignatureAttachmentDetail struct {
-	Name         string `json:"name"`
-	Instructions string `json:"instructions"`
-	SignerIndex  int    `json:"signer_index"`
-	Required     bool   `json:"required"`
-	UploadedAt   int64  `json:"uploaded_at"`
+	Name         string   `json:"name"`
+	Instructions string   `json:"instructions"`
+	SignerIndex  int      `json:"signer_index"`
+	Required     bool     `json:"required"`
+	UploadedAt   UnixTime `json:"uploaded_at"`
 }
 
 // FieldOptionsDetail is detail for field options
 type FieldOptionsDetail struct {
-	DateFormat string `json:"date_format"`
+	DateFormat DateFormat `json:"date_format"`
 }
 
 // FormFieldDetail is detail for form fields per document
 type FormFieldDetail struct {
-	APIID    string `json:"api_id"`
-	Type     string `json:"type"`
-	X        int    `json:"x"`
-	Y        int    `json:"y"`
-	Page     int    `json:"page"`
-	Width    int    `json:"width"`
-	Height   int    `json:"height"`
-	Required bool   `json:"required"`
-	Signer   int    `json:"signer"`
+	APIID          string   `json:"api_id"`
+	Name           string   `json:"name,omitempty"`
+	Type           string   `json:"type"`
+	X              int      `json:"x"`
+	Y              int      `json:"y"`
+	Page           int      `json:"page"`
+	Width          int      `json:"width"`
+	Height         int      `json:"height"`
+	Required       bool     `json:"required"`
+	Signer         int      `json:"signer"`
+	ValidationType string   `json:"validation_type,omitempty"`
+	Options        []string `json:"options,omitempty"`
+	Content        string   `json:"content,omitempty"`
+	Group          string   `json:"group,omitempty"`
+}
+
+// FormFieldGroupDetail is detail for a group of form fields, ex: radio buttons.
+// Requirement is one of FieldGroupRequirement constants.
+type FormFieldGroupDetail struct {
+	GroupID     string `json:"group_id"`
+	GroupLabel  string `json:"group_label"`
+	Requirement string `json:"requirement"`
 }
 
 // Get will return a signature request by signature request id
@@ -185,6 +322,53 @@ func (s *SignatureRequestAPI) Get(ctx context.Context, id string) (SignatureRequ
 	return signatureRequest, nil
 }
 
+// GetManyResult is the result of getting a single signature request in GetMany
+type GetManyResult struct {
+	ID               string
+	SignatureRequest SignatureRequest
+	Err              error
+}
+
+// GetMany will return signature requests by ids, in the same order as ids.
+// It calls Get with at most concurrency requests at a time, or one when concurrency is less than 1.
+// Set Client.RateLimiter to share a rate limit between the workers.
+// A failed Get is reported in Err of its result and does not stop the others.
+func (s *SignatureRequestAPI) GetMany(ctx context.Context, ids []string, concurrency int) []GetManyResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > len(ids) {
+		concurrency = len(ids)
+	}
+
+	results := make([]GetManyResult, len(ids))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				signatureRequest, err := s.Get(ctx, ids[i])
+				results[i] = GetManyResult{
+					ID:               ids[i],
+					SignatureRequest: signatureRequest,
+					Err:              err,
+				}
+			}
+		}()
+	}
+
+	for i := range ids {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
 // Fetch will return signture request list based on param
 func (s *SignatureRequestAPI) Fetch(ctx context.Context, p SignatureRequestListParam) (SignatureRequestList, error) {
 	path := s.client.BaseURL + subURLSignatureRequest
@@ -210,6 +394,7 @@ func (s *SignatureRequestAPI) Fetch(ctx context.Context, p SignatureRequestListP
 	if err != nil {
 		return SignatureRequestList{}, err
 	}
+	defer resp.Body.Close()
 
 	signatureRequestList := SignatureRequestList{}
 	err = json.NewDecoder(resp.Body).Decode(&signatureRequestList)
@@ -219,3 +404,614 @@ func (s *SignatureRequestAPI) Fetch(ctx context.Context, p SignatureRequestListP
 
 	return signatureRequestList, nil
 }
+
+// FetchAll will return a pager which iterates signature requests across all pages
+// starting from p.Page
+func (s *SignatureRequestAPI) FetchAll(p SignatureRequestListParam) *SignatureRequestPager {
+	fetch := func(ctx context.Context, page ListInfoQueryParam) ([]interface{}, ListInfo, error) {
+		param := p
+		param.ListInfoQueryParam = page
+
+		list, err := s.Fetch(ctx, param)
+		if err != nil {
+			return nil, ListInfo{}, err
+		}
+
+		items := make([]interface{}, 0, len(list.SignatureRequests))
+		for _, signatureRequest := range list.SignatureRequests {
+			items = append(items, signatureRequest)
+		}
+
+		return items, list.ListInfo, nil
+	}
+
+	return &SignatureRequestPager{NewPager(fetch, p.ListInfoQueryParam)}
+}
+
+// form encodes signature request payload into hellosign form syntax,
+// ex: signers[0][name]
+func (p SignatureRequestPayload) form() (form, error) {
+	f := form{}
+	f.addInt("test_mode", p.TestMode)
+	f.addList("file_url", p.FileURL)
+	f.add("title", p.Title)
+	f.add("subject", p.Subject)
+	f.add("message", p.Message)
+	f.add("signing_redirect_url", p.SigningRedirectURL)
+	f.addUnixTime("expires_at", p.ExpiresAt)
+
+	ordered := false
+	for _, signer := range p.Signers {
+		if signer.Order != 0 {
+			ordered = true
+			break
+		}
+	}
+
+	for i, signer := range p.Signers {
+		prefix := fmt.Sprintf("signers[%d]", i)
+		f.add(prefix+"[name]", signer.Name)
+		f.add(prefix+"[email_address]", signer.EmailAddress)
+		if ordered {
+			f.add(prefix+"[order]", strconv.Itoa(signer.Order))
+		}
+		f.add(prefix+"[pin]", signer.Pin)
+		f.add(prefix+"[sms_phone_number]", signer.SMSPhoneNumber)
+		f.add(prefix+"[sms_phone_number_type]", string(signer.SMSPhoneNumberType))
+		f.add(prefix+"[group]", signer.Group)
+
+		for j, member := range signer.Members {
+			f.add(fmt.Sprintf("%s[%d][name]", prefix, j), member.Name)
+			f.add(fmt.Sprintf("%s[%d][email_address]", prefix, j), member.EmailAddress)
+		}
+	}
+
+	for i, attachment := range p.Attachments {
+		prefix := fmt.Sprintf("attachments[%d]", i)
+		f.add(prefix+"[name]", attachment.Name)
+		f.add(prefix+"[instructions]", attachment.Instructions)
+		f.add(prefix+"[signer_index]", strconv.Itoa(attachment.SignerIndex))
+		f.addBool(prefix+"[required]", attachment.Required)
+	}
+
+	err := f.addCustomFields(p.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	f.addList("cc_email_addresses", p.CCEmailAddresses)
+	f.addInt("use_text_tags", p.UseTextTags)
+	f.addInt("hide_text_tags", p.HideTextTags)
+
+	f.addMetadata(p.Metadata)
+
+	f.add("client_id", p.ClientID)
+	f.addInt("allow_decline", p.AllowDecline)
+	f.addInt("allow_reassign", p.AllowReassign)
+
+	if len(p.FormFieldsPerDocument) > 0 {
+		err := f.addJSON("form_fields_per_document", p.FormFieldsPerDocument)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.FormFieldGroups) > 0 {
+		err := f.addJSON("form_field_groups", p.FormFieldGroups)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.SigningOptions != nil {
+		err := f.addJSON("signing_options", p.SigningOptions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.FieldOptions.DateFormat != "" {
+		err := f.addJSON("field_options", p.FieldOptions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// Send will create and send a new signature request with the submitted documents.
+// Documents can be uploaded as files or referenced by FileURL in the payload.
+// The payload is validated before sending, see SignatureRequestPayload.Validate.
+// Ref: https://app.hellosign.com/api/reference#send_signature_request
+func (s *SignatureRequestAPI) Send(ctx context.Context, payload SignatureRequestPayload, files ...File) (SignatureRequest, error) {
+	err := payload.Validate(files...)
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	f, err := payload.form()
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	return s.postForm(ctx, s.client.BaseURL+subURLSignatureRequestSend, f, indexedFiles("file", files))
+}
+
+// postForm sends form and files to path and decodes the signature request response
+func (s *SignatureRequestAPI) postForm(ctx context.Context, path string, f form, files []formFile) (SignatureRequest, error) {
+	body, writer, err := f.encode(files)
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	resp, err := s.client.callAPI(
+		ctx,
+		requestParam{
+			path:   path,
+			method: http.MethodPost,
+			body:   body,
+			writer: writer,
+		},
+	)
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+	defer resp.Body.Close()
+
+	signatureRequest := SignatureRequest{}
+	err = json.NewDecoder(resp.Body).Decode(&signatureRequest)
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	return signatureRequest, nil
+}
+
+// form encodes template signature request payload into hellosign form syntax,
+// ex: signers[Client][name]
+func (p SignatureRequestTemplatePayload) form() (form, error) {
+	f := form{}
+	f.addInt("test_mode", p.TestMode)
+	f.addList("template_ids", p.TemplateIDs)
+	f.add("title", p.Title)
+	f.add("subject", p.Subject)
+	f.add("message", p.Message)
+	f.add("signing_redirect_url", p.SigningRedirectURL)
+	f.addUnixTime("expires_at", p.ExpiresAt)
+
+	roles := make([]string, 0, len(p.Signers))
+	for role := range p.Signers {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	for _, role := range roles {
+		signer := p.Signers[role]
+		prefix := "signers[" + role + "]"
+		f.add(prefix+"[name]", signer.Name)
+		f.add(prefix+"[email_address]", signer.EmailAddress)
+		f.add(prefix+"[pin]", signer.Pin)
+		f.add(prefix+"[sms_phone_number]", signer.SMSPhoneNumber)
+		f.add(prefix+"[sms_phone_number_type]", string(signer.SMSPhoneNumberType))
+	}
+
+	f.addCCs(p.CCs)
+
+	err := f.addCustomFields(p.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	f.addMetadata(p.Metadata)
+	f.add("client_id", p.ClientID)
+	f.addInt("allow_decline", p.AllowDecline)
+
+	return f, nil
+}
+
+// SendWithTemplate will create and send a new signature request based on one or more templates.
+// Warnings from hellosign are returned in SignatureRequest.Warnings.
+// Ref: https://app.hellosign.com/api/reference#send_with_template
+func (s *SignatureRequestAPI) SendWithTemplate(ctx context.Context, param SignatureRequestTemplatePayload) (SignatureRequest, error) {
+	err := param.Validate()
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	f, err := param.form()
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	return s.postForm(ctx, s.client.BaseURL+subURLSignatureRequestSendWithTemplate, f, nil)
+}
+
+// Cancel will cancel an incomplete signature request.
+// Signers will no longer be able to sign the documents.
+// Ref: https://app.hellosign.com/api/reference#cancel_incomplete_signature_request
+func (s *SignatureRequestAPI) Cancel(ctx context.Context, id string) error {
+	resp, err := s.client.callAPI(
+		ctx,
+		requestParam{
+			path:   s.client.BaseURL + subURLSignatureRequestCancel + "/" + id,
+			method: http.MethodPost,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// ReleaseHold will release a signature request which is on hold because it was created
+// by an api app which is not approved yet, the signers will then receive the request.
+// Ref: https://app.hellosign.com/api/reference#release_signature_request_hold
+func (s *SignatureRequestAPI) ReleaseHold(ctx context.Context, id string) (SignatureRequest, error) {
+	return s.postForm(ctx, s.client.BaseURL+subURLSignatureRequestReleaseHold+"/"+id, nil, nil)
+}
+
+// Remove will remove your access to a completed signature request.
+// This action is not reversible.
+// Ref: https://app.hellosign.com/api/reference#remove_signature_request_access
+func (s *SignatureRequestAPI) Remove(ctx context.Context, id string) error {
+	resp, err := s.client.callAPI(
+		ctx,
+		requestParam{
+			path:   s.client.BaseURL + subURLSignatureRequestRemove + "/" + id,
+			method: http.MethodPost,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// RemindParam is request param for send a reminder to a signer.
+// Name is only required when the signer is part of a signer group.
+type RemindParam struct {
+	EmailAddress string
+	Name         string
+}
+
+// Remind will send an email to the signer reminding them to sign the signature request.
+// You cannot send a reminder within 1 hour of the last reminder.
+// Ref: https://app.hellosign.com/api/reference#send_request_reminder
+func (s *SignatureRequestAPI) Remind(ctx context.Context, id string, param RemindParam) (SignatureRequest, error) {
+	f := form{}
+	f.add("email_address", param.EmailAddress)
+	f.add("name", param.Name)
+
+	return s.postForm(ctx, s.client.BaseURL+subURLSignatureRequestRemind+"/"+id, f, nil)
+}
+
+// Edit will edit and resend a signature request, keeping its history.
+// The payload replaces the request, same as Send.
+// Ref: https://app.hellosign.com/api/reference#edit_and_resend_signature_request
+func (s *SignatureRequestAPI) Edit(ctx context.Context, id string, payload SignatureRequestPayload, files ...File) (SignatureRequest, error) {
+	err := payload.Validate(files...)
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	f, err := payload.form()
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	return s.postForm(ctx, s.client.BaseURL+subURLSignatureRequestEdit+"/"+id, f, indexedFiles("file", files))
+}
+
+// EditEmbedded will edit and resend an embedded signature request, keeping its history.
+// The payload replaces the request, same as CreateEmbedded. Param ClientID is required.
+// Ref: https://app.hellosign.com/api/reference#edit_and_resend_embedded_signature_request
+func (s *SignatureRequestAPI) EditEmbedded(ctx context.Context, id string, payload SignatureRequestPayload, files ...File) (SignatureRequest, error) {
+	if payload.ClientID == "" {
+		return SignatureRequest{}, ErrMissingClientID
+	}
+
+	err := payload.Validate(files...)
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	f, err := payload.form()
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	return s.postForm(ctx, s.client.BaseURL+subURLSignatureRequestEditEmbedded+"/"+id, f, indexedFiles("file", files))
+}
+
+// EditWithTemplate will edit and resend a signature request based on templates, keeping its history.
+// The param replaces the request, same as SendWithTemplate.
+// Ref: https://app.hellosign.com/api/reference#edit_and_resend_signature_request_with_template
+func (s *SignatureRequestAPI) EditWithTemplate(ctx context.Context, id string, param SignatureRequestTemplatePayload) (SignatureRequest, error) {
+	err := param.Validate()
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	f, err := param.form()
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	return s.postForm(ctx, s.client.BaseURL+subURLSignatureRequestEditWithTemplate+"/"+id, f, nil)
+}
+
+// UpdateSigner will update email address and name of a signer, ex: to correct a mistyped email address.
+// signatureID is the signature id of the signer, empty newName keeps the current name.
+// Ref: https://app.hellosign.com/api/reference#update_signature_request
+func (s *SignatureRequestAPI) UpdateSigner(ctx context.Context, id, signatureID, newEmail, newName string) (SignatureRequest, error) {
+	errs := ValidationErrors{}
+	if signatureID == "" {
+		errs.add("signature_id", "is required")
+	}
+	if newEmail == "" && newName == "" {
+		errs.add("email_address", "email_address or name is required")
+	}
+	err := errs.err()
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	f := form{}
+	f.add("signature_id", signatureID)
+	f.add("email_address", newEmail)
+	f.add("name", newName)
+
+	return s.postForm(ctx, s.client.BaseURL+subURLSignatureRequestUpdate+"/"+id, f, nil)
+}
+
+// FileType is a file type of downloaded signature request documents
+type FileType string
+
+const (
+	// FileTypePDF is a single merged pdf of all documents
+	FileTypePDF FileType = "pdf"
+	// FileTypeZIP is a zip file of all documents as separate pdfs
+	FileTypeZIP FileType = "zip"
+)
+
+// FilesParam is request param for download signature request documents.
+// If GetURL or GetDataURI is set, hellosign returns a link or data uri instead of the file.
+type FilesParam struct {
+	FileType   FileType
+	GetURL     bool
+	GetDataURI bool
+}
+
+// SignatureRequestFiles is a response for signature request files
+// requested with GetURL or GetDataURI
+type SignatureRequestFiles struct {
+	FileURL   string   `json:"file_url"`
+	DataURI   string   `json:"data_uri"`
+	ExpiresAt UnixTime `json:"expires_at"`
+}
+
+// Files will download the documents of a signature request.
+// The file is streamed into w, which may be nil when param.GetURL or param.GetDataURI is set.
+// Downloads use Client.DownloadTimeout instead of the HTTPClient timeout.
+// Ref: https://app.hellosign.com/api/reference#get_files
+func (s *SignatureRequestAPI) Files(ctx context.Context, id string, param FilesParam, w io.Writer) (SignatureRequestFiles, error) {
+	download := !param.GetURL && !param.GetDataURI
+	if download && w == nil {
+		return SignatureRequestFiles{}, errors.New("hellosign: writer is required to download files")
+	}
+
+	req, err := s.client.prepareRequest(
+		ctx,
+		requestParam{
+			path:   s.client.BaseURL + subURLSignatureRequestFiles + "/" + id,
+			method: http.MethodGet,
+		})
+	if err != nil {
+		return SignatureRequestFiles{}, err
+	}
+
+	q := req.URL.Query()
+	if param.FileType != "" {
+		q.Add("file_type", string(param.FileType))
+	}
+	if param.GetURL {
+		q.Add("get_url", "1")
+	}
+	if param.GetDataURI {
+		q.Add("get_data_uri", "1")
+	}
+	req.URL.RawQuery = q.Encode()
+
+	if !download {
+		resp, err := s.client.executeRequest(req)
+		if err != nil {
+			return SignatureRequestFiles{}, err
+		}
+		defer resp.Body.Close()
+
+		files := SignatureRequestFiles{}
+		err = json.NewDecoder(resp.Body).Decode(&files)
+		if err != nil {
+			return SignatureRequestFiles{}, err
+		}
+
+		return files, nil
+	}
+
+	resp, err := s.client.doRequest(s.client.downloadClient(), req)
+	if err != nil {
+		return SignatureRequestFiles{}, err
+	}
+	defer resp.Body.Close()
+
+	_, err = io.Copy(w, resp.Body)
+	if err != nil {
+		return SignatureRequestFiles{}, err
+	}
+
+	return SignatureRequestFiles{}, nil
+}
+
+// CreateEmbedded will create a new signature request to be signed in an iframe on your site.
+// Payload ClientID is required. Use EmbeddedAPI.GetSignURL to get the url for each signer.
+// Ref: https://app.hellosign.com/api/reference#create_embedded_signature_request
+func (s *SignatureRequestAPI) CreateEmbedded(ctx context.Context, payload SignatureRequestPayload, files ...File) (SignatureRequest, error) {
+	if payload.ClientID == "" {
+		return SignatureRequest{}, ErrMissingClientID
+	}
+
+	err := payload.Validate(files...)
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	f, err := payload.form()
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	return s.postForm(ctx, s.client.BaseURL+subURLSignatureRequestCreateEmbedded, f, indexedFiles("file", files))
+}
+
+// CreateEmbeddedWithTemplate will create a new signature request based on templates
+// to be signed in an iframe on your site. Param ClientID is required.
+// Ref: https://app.hellosign.com/api/reference#create_embedded_signature_request_with_template
+func (s *SignatureRequestAPI) CreateEmbeddedWithTemplate(ctx context.Context, param SignatureRequestTemplatePayload) (SignatureRequest, error) {
+	if param.ClientID == "" {
+		return SignatureRequest{}, ErrMissingClientID
+	}
+
+	err := param.Validate()
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	f, err := param.form()
+	if err != nil {
+		return SignatureRequest{}, err
+	}
+
+	return s.postForm(ctx, s.client.BaseURL+subURLSignatureRequestCreateEmbeddedWithTemplate, f, nil)
+}
+
+// BulkSendWithTemplatePayload is payload for bulk send signature requests based on templates.
+// Either SignerFile or SignerList must be set, each signer row creates one signature request.
+// Unlike the send payloads it has no ExpiresAt, requests of a bulk send job don't expire.
+type BulkSendWithTemplatePayload struct {
+	TestMode           int                    `json:"test_mode"`
+	TemplateIDs        []string               `json:"template_ids"`
+	SignerFile         *File                  `json:"-"`
+	SignerList         []BulkSendSignerDetail `json:"signer_list"`
+	Title              string                 `json:"title"`
+	Subject            string                 `json:"subject"`
+	Message            string                 `json:"message"`
+	SigningRedirectURL string                 `json:"signing_redirect_url"`
+	CCs                map[string]string      `json:"ccs"`
+	CustomFields       []CustomFieldsDetail   `json:"custom_fields"`
+	Metadata           map[string]interface{} `json:"metadata"`
+	ClientID           string                 `json:"client_id"`
+	AllowDecline       int                    `json:"allow_decline"`
+}
+
+// BulkSendSignerDetail is detail for signers of a single signature request in a bulk send.
+// Signers are keyed by template role name.
+type BulkSendSignerDetail struct {
+	Signers      map[string]TemplateSignerDetail `json:"signers"`
+	CustomFields []CustomFieldsDetail            `json:"custom_fields"`
+}
+
+// form encodes bulk send payload into hellosign form syntax
+func (p BulkSendWithTemplatePayload) form() (form, error) {
+	f := form{}
+	f.addInt("test_mode", p.TestMode)
+	f.addList("template_ids", p.TemplateIDs)
+
+	if len(p.SignerList) > 0 {
+		type signerList struct {
+			Signers      map[string]TemplateSignerDetail `json:"signers"`
+			CustomFields []customFieldValue              `json:"custom_fields,omitempty"`
+		}
+
+		list := make([]signerList, 0, len(p.SignerList))
+		for _, signers := range p.SignerList {
+			list = append(list, signerList{
+				Signers:      signers.Signers,
+				CustomFields: customFieldValues(signers.CustomFields),
+			})
+		}
+
+		err := f.addJSON("signer_list", list)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f.add("title", p.Title)
+	f.add("subject", p.Subject)
+	f.add("message", p.Message)
+	f.add("signing_redirect_url", p.SigningRedirectURL)
+	f.addCCs(p.CCs)
+
+	err := f.addCustomFields(p.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	f.addMetadata(p.Metadata)
+	f.add("client_id", p.ClientID)
+	f.addInt("allow_decline", p.AllowDecline)
+
+	return f, nil
+}
+
+// BulkSendWithTemplate will create a bulk send job which sends a signature request
+// based on templates to each signer row in SignerFile or SignerList.
+// Use BulkSendJobAPI to follow the progress of the job.
+// Ref: https://app.hellosign.com/api/reference#bulk_send_with_template
+func (s *SignatureRequestAPI) BulkSendWithTemplate(ctx context.Context, payload BulkSendWithTemplatePayload) (BulkSendJob, error) {
+	err := payload.Validate()
+	if err != nil {
+		return BulkSendJob{}, err
+	}
+
+	f, err := payload.form()
+	if err != nil {
+		return BulkSendJob{}, err
+	}
+
+	var files []formFile
+	if payload.SignerFile != nil {
+		files = append(files, formFile{key: "signer_file", file: *payload.SignerFile})
+	}
+
+	body, writer, err := f.encode(files)
+	if err != nil {
+		return BulkSendJob{}, err
+	}
+
+	resp, err := s.client.callAPI(
+		ctx,
+		requestParam{
+			path:   s.client.BaseURL + subURLSignatureRequestBulkSendWithTemplate,
+			method: http.MethodPost,
+			body:   body,
+			writer: writer,
+		},
+	)
+	if err != nil {
+		return BulkSendJob{}, err
+	}
+	defer resp.Body.Close()
+
+	bulkSendJob := BulkSendJob{}
+	err = json.NewDecoder(resp.Body).Decode(&bulkSendJob)
+	if err != nil {
+		return BulkSendJob{}, err
+	}
+
+	return bulkSendJob, nil
+}
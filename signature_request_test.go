@@ -7,7 +7,12 @@ import (
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/matryer/is"
 
@@ -23,6 +28,7 @@ func TestSignatureRequest_Get(t *testing.T) {
 	signatureRequest := hellosign.SignatureRequest{}
 	err := json.Unmarshal(signatureRequestJSON, &signatureRequest)
 	is.NoErr(err)
+	is.Equal(time.Date(2019, time.November, 7, 17, 57, 47, 0, time.UTC), signatureRequest.SignatureRequest.ExpiresAt.Time())
 
 	errNotFoundJSON := testdata.GetGolden(t, "signature-request-err-not-found")
 
@@ -74,6 +80,79 @@ func TestSignatureRequest_Get(t *testing.T) {
 	}
 }
 
+// countingLimiter counts waits of requests
+type countingLimiter struct {
+	waits int32
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	atomic.AddInt32(&l.waits, 1)
+	return ctx.Err()
+}
+
+func TestSignatureRequest_GetMany(t *testing.T) {
+	is := is.New(t)
+
+	signatureRequestJSON := testdata.GetGolden(t, "signature-request")
+	errNotFoundJSON := testdata.GetGolden(t, "signature-request-err-not-found")
+
+	ids := []string{"a1", "b2", "missing", "c3", "d4"}
+
+	var active, maxActive int32
+	mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			max := atomic.LoadInt32(&maxActive)
+			if n <= max || atomic.CompareAndSwapInt32(&maxActive, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		if strings.HasSuffix(req.URL.Path, "/missing") {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(bytes.NewReader(errNotFoundJSON)),
+				Header:     make(http.Header),
+			}
+		}
+
+		body := bytes.Replace(signatureRequestJSON, []byte("fa5c8a0b0f492d768749333ad6fcc214c111e967"), []byte(path.Base(req.URL.Path)), 1)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	limiter := &countingLimiter{}
+	apiClient := hellosign.NewClient("123")
+	apiClient.HTTPClient = mockHTTPClient
+	apiClient.RateLimiter = limiter
+
+	results := apiClient.SignatureRequestAPI.GetMany(context.TODO(), ids, 2)
+	is.Equal(len(ids), len(results))
+	for i, result := range results {
+		is.Equal(ids[i], result.ID)
+		if result.ID == "missing" {
+			is.Equal("not_found: Not found", result.Err.Error())
+			continue
+		}
+		is.NoErr(result.Err)
+		is.Equal(ids[i], result.SignatureRequest.SignatureRequest.SignatureRequestID)
+	}
+	is.Equal(int32(len(ids)), atomic.LoadInt32(&limiter.waits))
+	is.True(atomic.LoadInt32(&maxActive) <= 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results = apiClient.SignatureRequestAPI.GetMany(ctx, ids[:2], 0)
+	for _, result := range results {
+		is.True(errors.Is(result.Err, context.Canceled))
+	}
+}
+
 func TestSignatureRequest_Fetch(t *testing.T) {
 	is := is.New(t)
 
@@ -125,3 +204,974 @@ func TestSignatureRequest_Fetch(t *testing.T) {
 		})
 	}
 }
+
+func TestSignatureRequest_Send(t *testing.T) {
+	is := is.New(t)
+
+	signatureRequestJSON := testdata.GetGolden(t, "signature-request")
+	signatureRequest := hellosign.SignatureRequest{}
+	err := json.Unmarshal(signatureRequestJSON, &signatureRequest)
+	is.NoErr(err)
+
+	errBadRequestJSON := testdata.GetGolden(t, "account-err-bad-request")
+
+	tests := map[string]struct {
+		payload                  hellosign.SignatureRequestPayload
+		files                    []hellosign.File
+		expectedFields           map[string]string
+		expectedFileName         string
+		signatureResponse        http.Response
+		expectedSignatureRequest hellosign.SignatureRequest
+		expectedError            error
+	}{
+		"success": {
+			payload: hellosign.SignatureRequestPayload{
+				TestMode: 1,
+				Title:    "Purchase Agreement",
+				Signers: []hellosign.SignerDetail{
+					{Name: "John Doe", EmailAddress: "john@example.com"},
+					{Name: "Jane Doe", EmailAddress: "jane@example.com", Order: 1, Pin: "0042", SMSPhoneNumber: "+14155550100", SMSPhoneNumberType: hellosign.SMSPhoneNumberTypeAuthentication},
+					{Group: "Legal", Order: 2, Members: []hellosign.SignerGroupDetail{{Name: "Ann", EmailAddress: "ann@example.com"}}},
+				},
+				CCEmailAddresses: []string{"me@hellosign.com"},
+				Metadata:         map[string]interface{}{"employee_id": 1234},
+				SigningOptions: &hellosign.SigningOptions{
+					Draw:    true,
+					Type:    true,
+					Default: hellosign.SigningOptionType,
+				},
+				FieldOptions: hellosign.FieldOptionsDetail{
+					DateFormat: hellosign.DateFormat6,
+				},
+				FormFieldsPerDocument: [][]hellosign.FormFieldDetail{
+					{
+						{APIID: "sign_1", Type: hellosign.FieldSignature, Width: 120, Height: 30, Signer: 0, Page: 1},
+					},
+				},
+			},
+			files: []hellosign.File{
+				{Name: "agreement.pdf", Reader: bytes.NewReader([]byte("%PDF-1.4"))},
+			},
+			expectedFields: map[string]string{
+				"test_mode":                         "1",
+				"title":                             "Purchase Agreement",
+				"signers[0][name]":                  "John Doe",
+				"signers[0][email_address]":         "john@example.com",
+				"signers[0][order]":                 "0",
+				"signers[1][name]":                  "Jane Doe",
+				"signers[1][email_address]":         "jane@example.com",
+				"signers[1][order]":                 "1",
+				"signers[1][pin]":                   "0042",
+				"signers[1][sms_phone_number]":      "+14155550100",
+				"signers[1][sms_phone_number_type]": "authentication",
+				"signers[2][group]":                 "Legal",
+				"signers[2][0][name]":               "Ann",
+				"signers[2][0][email_address]":      "ann@example.com",
+				"cc_email_addresses[0]":             "me@hellosign.com",
+				"metadata[employee_id]":             "1234",
+				"signing_options":                   `{"draw":true,"type":true,"upload":false,"phone":false,"default":"type"}`,
+				"field_options":                     `{"date_format":"YYYY - MM - DD"}`,
+				"form_fields_per_document":          `[[{"api_id":"sign_1","type":"signature","x":0,"y":0,"page":1,"width":120,"height":30,"required":false,"signer":0}]]`,
+			},
+			expectedFileName: "agreement.pdf",
+			signatureResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(signatureRequestJSON)),
+				Header:     make(http.Header),
+			},
+			expectedSignatureRequest: signatureRequest,
+			expectedError:            nil,
+		},
+		"bad request": {
+			payload: hellosign.SignatureRequestPayload{
+				FileURL: []string{"https://example.com/agreement.pdf"},
+				Signers: []hellosign.SignerDetail{
+					{Name: "John Doe", EmailAddress: "john@example.com"},
+				},
+			},
+			expectedFields: map[string]string{
+				"file_url[0]":      "https://example.com/agreement.pdf",
+				"signers[0][name]": "John Doe",
+			},
+			signatureResponse: http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       ioutil.NopCloser(bytes.NewReader(errBadRequestJSON)),
+				Header:     make(http.Header),
+			},
+			expectedSignatureRequest: hellosign.SignatureRequest{},
+			expectedError:            errors.New("bad_request: Invalid parameter: email_addres"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal(http.MethodPost, req.Method)
+				is.Equal("/v3/signature_request/send", req.URL.Path)
+
+				err := req.ParseMultipartForm(1 << 20)
+				is.NoErr(err)
+				for k, v := range test.expectedFields {
+					is.Equal(v, req.FormValue(k))
+				}
+				if test.expectedFileName != "" {
+					is.Equal(test.expectedFileName, req.MultipartForm.File["file[0]"][0].Filename)
+				}
+
+				return &test.signatureResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.SignatureRequestAPI.Send(context.TODO(), test.payload, test.files...)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedSignatureRequest, resp)
+		})
+	}
+}
+
+func TestSignatureRequest_SendWithTemplate(t *testing.T) {
+	is := is.New(t)
+
+	signatureRequest := hellosign.SignatureRequest{
+		SignatureRequest: hellosign.SignatureRequestDetail{
+			SignatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+			Title:              "Purchase Agreement",
+		},
+		Warnings: []hellosign.Warnings{
+			{
+				WarningMessage: "Custom field Cost does not exist in the template",
+				WarningName:    "parameter_ignored",
+			},
+		},
+	}
+	signatureRequestJSON, err := json.Marshal(signatureRequest)
+	is.NoErr(err)
+
+	expiresAt := hellosign.NewUnixTime(time.Now().Add(72 * time.Hour))
+
+	tests := map[string]struct {
+		param                    hellosign.SignatureRequestTemplatePayload
+		expectedFields           map[string]string
+		signatureResponse        http.Response
+		expectedSignatureRequest hellosign.SignatureRequest
+		expectedError            error
+	}{
+		"success": {
+			param: hellosign.SignatureRequestTemplatePayload{
+				TemplateIDs: []string{"c26b8a16784a872da37ea946b9ddec7c1e11dff6", "b7fee29dd746ebab9d8cdfcb7cdbdd0c5a2bc67a"},
+				Title:       "Purchase Agreement",
+				Signers: map[string]hellosign.TemplateSignerDetail{
+					"Client": {Name: "John Doe", EmailAddress: "john@example.com"},
+				},
+				CCs: map[string]string{
+					"Accounting": "accounting@example.com",
+				},
+				CustomFields: []hellosign.CustomFieldsDetail{
+					{Name: "Cost", Value: "$20,000", Editor: "Client", Required: true},
+				},
+				ExpiresAt: expiresAt,
+			},
+			expectedFields: map[string]string{
+				"template_ids[0]":                "c26b8a16784a872da37ea946b9ddec7c1e11dff6",
+				"template_ids[1]":                "b7fee29dd746ebab9d8cdfcb7cdbdd0c5a2bc67a",
+				"title":                          "Purchase Agreement",
+				"signers[Client][name]":          "John Doe",
+				"signers[Client][email_address]": "john@example.com",
+				"ccs[Accounting][email_address]": "accounting@example.com",
+				"custom_fields":                  `[{"name":"Cost","value":"$20,000","editor":"Client","required":true}]`,
+				"expires_at":                     strconv.FormatInt(int64(expiresAt), 10),
+			},
+			signatureResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(signatureRequestJSON)),
+				Header:     make(http.Header),
+			},
+			expectedSignatureRequest: signatureRequest,
+			expectedError:            nil,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal("/v3/signature_request/send_with_template", req.URL.Path)
+
+				err := req.ParseMultipartForm(1 << 20)
+				is.NoErr(err)
+				for k, v := range test.expectedFields {
+					is.Equal(v, req.FormValue(k))
+				}
+
+				return &test.signatureResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.SignatureRequestAPI.SendWithTemplate(context.TODO(), test.param)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedSignatureRequest, resp)
+			is.True(resp.CheckWarnings())
+		})
+	}
+}
+
+func TestSignatureRequest_Cancel(t *testing.T) {
+	errNotFoundJSON := testdata.GetGolden(t, "signature-request-err-not-found")
+
+	tests := map[string]struct {
+		signatureRequestID string
+		signatureResponse  http.Response
+		expectedError      error
+	}{
+		"success": {
+			signatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+			signatureResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+				Header:     make(http.Header),
+			},
+			expectedError: nil,
+		},
+		"not found": {
+			signatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+			signatureResponse: http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(bytes.NewReader(errNotFoundJSON)),
+				Header:     make(http.Header),
+			},
+			expectedError: errors.New("not_found: Not found"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal(http.MethodPost, req.Method)
+				is.Equal("/v3/signature_request/cancel/"+test.signatureRequestID, req.URL.Path)
+				return &test.signatureResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			err := apiClient.SignatureRequestAPI.Cancel(context.TODO(), test.signatureRequestID)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+		})
+	}
+}
+
+func TestSignatureRequest_Remove(t *testing.T) {
+	errNotFoundJSON := testdata.GetGolden(t, "signature-request-err-not-found")
+
+	tests := map[string]struct {
+		signatureRequestID string
+		signatureResponse  http.Response
+		expectedError      error
+	}{
+		"success": {
+			signatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+			signatureResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+				Header:     make(http.Header),
+			},
+			expectedError: nil,
+		},
+		"not found": {
+			signatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+			signatureResponse: http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(bytes.NewReader(errNotFoundJSON)),
+				Header:     make(http.Header),
+			},
+			expectedError: errors.New("not_found: Not found"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal(http.MethodPost, req.Method)
+				is.Equal("/v3/signature_request/remove/"+test.signatureRequestID, req.URL.Path)
+				return &test.signatureResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			err := apiClient.SignatureRequestAPI.Remove(context.TODO(), test.signatureRequestID)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+		})
+	}
+}
+
+func TestSignatureRequest_Remind(t *testing.T) {
+	is := is.New(t)
+
+	signatureRequestJSON := testdata.GetGolden(t, "signature-request")
+	signatureRequest := hellosign.SignatureRequest{}
+	err := json.Unmarshal(signatureRequestJSON, &signatureRequest)
+	is.NoErr(err)
+
+	tests := map[string]struct {
+		signatureRequestID       string
+		param                    hellosign.RemindParam
+		signatureResponse        http.Response
+		expectedSignatureRequest hellosign.SignatureRequest
+		expectedError            error
+	}{
+		"success": {
+			signatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+			param: hellosign.RemindParam{
+				EmailAddress: "john@example.com",
+			},
+			signatureResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(signatureRequestJSON)),
+				Header:     make(http.Header),
+			},
+			expectedSignatureRequest: signatureRequest,
+			expectedError:            nil,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal("/v3/signature_request/remind/"+test.signatureRequestID, req.URL.Path)
+
+				err := req.ParseMultipartForm(1 << 20)
+				is.NoErr(err)
+				is.Equal(test.param.EmailAddress, req.FormValue("email_address"))
+
+				return &test.signatureResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.SignatureRequestAPI.Remind(context.TODO(), test.signatureRequestID, test.param)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedSignatureRequest, resp)
+		})
+	}
+}
+
+func TestSignatureRequest_ReleaseHold(t *testing.T) {
+	is := is.New(t)
+
+	signatureRequestJSON := testdata.GetGolden(t, "signature-request")
+	signatureRequest := hellosign.SignatureRequest{}
+	err := json.Unmarshal(signatureRequestJSON, &signatureRequest)
+	is.NoErr(err)
+
+	errNotFoundJSON := testdata.GetGolden(t, "signature-request-err-not-found")
+
+	tests := map[string]struct {
+		signatureRequestID       string
+		signatureResponse        http.Response
+		expectedSignatureRequest hellosign.SignatureRequest
+		expectedError            error
+	}{
+		"success": {
+			signatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+			signatureResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(signatureRequestJSON)),
+				Header:     make(http.Header),
+			},
+			expectedSignatureRequest: signatureRequest,
+			expectedError:            nil,
+		},
+		"not found": {
+			signatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+			signatureResponse: http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(bytes.NewReader(errNotFoundJSON)),
+				Header:     make(http.Header),
+			},
+			expectedError: errors.New("not_found: Not found"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal(http.MethodPost, req.Method)
+				is.Equal("/v3/signature_request/release_hold/"+test.signatureRequestID, req.URL.Path)
+				return &test.signatureResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.SignatureRequestAPI.ReleaseHold(context.TODO(), test.signatureRequestID)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedSignatureRequest, resp)
+		})
+	}
+}
+
+func TestSignatureRequest_Edit(t *testing.T) {
+	is := is.New(t)
+
+	signatureRequestJSON := testdata.GetGolden(t, "signature-request")
+	signatureRequest := hellosign.SignatureRequest{}
+	err := json.Unmarshal(signatureRequestJSON, &signatureRequest)
+	is.NoErr(err)
+
+	id := "fa5c8a0b0f492d768749333ad6fcc214c111e967"
+	payload := hellosign.SignatureRequestPayload{
+		FileURL: []string{"https://example.com/agreement.pdf"},
+		Title:   "Purchase Agreement",
+		Signers: []hellosign.SignerDetail{
+			{Name: "John Doe", EmailAddress: "john@example.com"},
+		},
+	}
+	embeddedPayload := payload
+	embeddedPayload.ClientID = "0a6f5a0b1e7a4a8a"
+	templatePayload := hellosign.SignatureRequestTemplatePayload{
+		TemplateIDs: []string{"c26b8a16784a872da37ea946b9ddec7c1e11dff6"},
+		Title:       "Purchase Agreement",
+		Signers: map[string]hellosign.TemplateSignerDetail{
+			"Client": {Name: "John Doe", EmailAddress: "john@example.com"},
+		},
+	}
+
+	tests := map[string]struct {
+		edit           func(api *hellosign.SignatureRequestAPI) (hellosign.SignatureRequest, error)
+		expectedPath   string
+		expectedFields map[string]string
+		expectedError  error
+	}{
+		"edit": {
+			edit: func(api *hellosign.SignatureRequestAPI) (hellosign.SignatureRequest, error) {
+				return api.Edit(context.TODO(), id, payload)
+			},
+			expectedPath: "/v3/signature_request/edit/" + id,
+			expectedFields: map[string]string{
+				"file_url[0]":               "https://example.com/agreement.pdf",
+				"title":                     "Purchase Agreement",
+				"signers[0][email_address]": "john@example.com",
+			},
+		},
+		"edit embedded": {
+			edit: func(api *hellosign.SignatureRequestAPI) (hellosign.SignatureRequest, error) {
+				return api.EditEmbedded(context.TODO(), id, embeddedPayload)
+			},
+			expectedPath: "/v3/signature_request/edit_embedded/" + id,
+			expectedFields: map[string]string{
+				"client_id": "0a6f5a0b1e7a4a8a",
+				"title":     "Purchase Agreement",
+			},
+		},
+		"edit embedded without client id": {
+			edit: func(api *hellosign.SignatureRequestAPI) (hellosign.SignatureRequest, error) {
+				return api.EditEmbedded(context.TODO(), id, payload)
+			},
+			expectedError: hellosign.ErrMissingClientID,
+		},
+		"edit with template": {
+			edit: func(api *hellosign.SignatureRequestAPI) (hellosign.SignatureRequest, error) {
+				return api.EditWithTemplate(context.TODO(), id, templatePayload)
+			},
+			expectedPath: "/v3/signature_request/edit_with_template/" + id,
+			expectedFields: map[string]string{
+				"template_ids[0]":                "c26b8a16784a872da37ea946b9ddec7c1e11dff6",
+				"signers[Client][email_address]": "john@example.com",
+			},
+		},
+		"update signer": {
+			edit: func(api *hellosign.SignatureRequestAPI) (hellosign.SignatureRequest, error) {
+				return api.UpdateSigner(context.TODO(), id, "78caf2a1d01cd39cea2bc1cbb340dac3", "john.doe@example.com", "")
+			},
+			expectedPath: "/v3/signature_request/update/" + id,
+			expectedFields: map[string]string{
+				"signature_id":  "78caf2a1d01cd39cea2bc1cbb340dac3",
+				"email_address": "john.doe@example.com",
+				"name":          "",
+			},
+		},
+		"update signer without email and name": {
+			edit: func(api *hellosign.SignatureRequestAPI) (hellosign.SignatureRequest, error) {
+				return api.UpdateSigner(context.TODO(), id, "78caf2a1d01cd39cea2bc1cbb340dac3", "", "")
+			},
+			expectedError: errors.New("hellosign: invalid payload: email_address: email_address or name is required"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal(http.MethodPost, req.Method)
+				is.Equal(test.expectedPath, req.URL.Path)
+
+				err := req.ParseMultipartForm(1 << 20)
+				is.NoErr(err)
+				for k, v := range test.expectedFields {
+					is.Equal(v, req.FormValue(k))
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(bytes.NewReader(signatureRequestJSON)),
+					Header:     make(http.Header),
+				}
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := test.edit(apiClient.SignatureRequestAPI)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(signatureRequest, resp)
+		})
+	}
+}
+
+func TestSignatureRequest_Files(t *testing.T) {
+	is := is.New(t)
+
+	filesURL := hellosign.SignatureRequestFiles{
+		FileURL:   "https://s3.amazonaws.com/hellofax_uploads/super_groups/2016/10/03/fa5c8a0b0f492d768749333ad6fcc214c111e967.pdf",
+		ExpiresAt: 1475528941,
+	}
+	filesURLJSON, err := json.Marshal(filesURL)
+	is.NoErr(err)
+
+	errNotFoundJSON := testdata.GetGolden(t, "signature-request-err-not-found")
+
+	tests := map[string]struct {
+		signatureRequestID string
+		param              hellosign.FilesParam
+		expectedQuery      string
+		filesResponse      http.Response
+		expectedFiles      hellosign.SignatureRequestFiles
+		expectedContent    []byte
+		expectedError      error
+	}{
+		"download zip": {
+			signatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+			param: hellosign.FilesParam{
+				FileType: hellosign.FileTypeZIP,
+			},
+			expectedQuery: "file_type=zip",
+			filesResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte("PK\x03\x04"))),
+				Header:     make(http.Header),
+			},
+			expectedFiles:   hellosign.SignatureRequestFiles{},
+			expectedContent: []byte("PK\x03\x04"),
+			expectedError:   nil,
+		},
+		"get url": {
+			signatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+			param: hellosign.FilesParam{
+				FileType: hellosign.FileTypePDF,
+				GetURL:   true,
+			},
+			expectedQuery: "file_type=pdf&get_url=1",
+			filesResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(filesURLJSON)),
+				Header:     make(http.Header),
+			},
+			expectedFiles: filesURL,
+			expectedError: nil,
+		},
+		"not found": {
+			signatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+			param: hellosign.FilesParam{
+				FileType: hellosign.FileTypePDF,
+			},
+			expectedQuery: "file_type=pdf",
+			filesResponse: http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(bytes.NewReader(errNotFoundJSON)),
+				Header:     make(http.Header),
+			},
+			expectedError: errors.New("not_found: Not found"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal("/v3/signature_request/files/"+test.signatureRequestID, req.URL.Path)
+				is.Equal(test.expectedQuery, req.URL.RawQuery)
+				return &test.filesResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+
+			var content bytes.Buffer
+			resp, err := apiClient.SignatureRequestAPI.Files(context.TODO(), test.signatureRequestID, test.param, &content)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedFiles, resp)
+			is.Equal(test.expectedContent, content.Bytes())
+		})
+	}
+}
+
+func TestSignatureRequest_FilesDownloadTimeout(t *testing.T) {
+	filesURLJSON, err := json.Marshal(hellosign.SignatureRequestFiles{FileURL: "https://example.com/agreement.pdf"})
+	is.New(t).NoErr(err)
+
+	tests := map[string]struct {
+		param         hellosign.FilesParam
+		responseBody  []byte
+		expectedError bool
+	}{
+		"download uses download timeout": {
+			param:         hellosign.FilesParam{FileType: hellosign.FileTypePDF},
+			responseBody:  []byte("%PDF-1.4"),
+			expectedError: false,
+		},
+		"url uses http client timeout": {
+			param:         hellosign.FilesParam{GetURL: true},
+			responseBody:  filesURLJSON,
+			expectedError: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				select {
+				case <-time.After(200 * time.Millisecond):
+				case <-req.Context().Done():
+					return nil
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(bytes.NewReader(test.responseBody)),
+					Header:     make(http.Header),
+				}
+			})
+			mockHTTPClient.Timeout = 50 * time.Millisecond
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			apiClient.DownloadTimeout = 5 * time.Second
+
+			var content bytes.Buffer
+			_, err := apiClient.SignatureRequestAPI.Files(context.TODO(), "fa5c8a0b0f492d768749333ad6fcc214c111e967", test.param, &content)
+			if test.expectedError {
+				is.True(err != nil)
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.responseBody, content.Bytes())
+			is.Equal(50*time.Millisecond, apiClient.HTTPClient.Timeout)
+		})
+	}
+}
+
+func TestSignatureRequest_CreateEmbedded(t *testing.T) {
+	is := is.New(t)
+
+	signatureRequestJSON := testdata.GetGolden(t, "signature-request")
+	signatureRequest := hellosign.SignatureRequest{}
+	err := json.Unmarshal(signatureRequestJSON, &signatureRequest)
+	is.NoErr(err)
+
+	tests := map[string]struct {
+		payload                  hellosign.SignatureRequestPayload
+		signatureResponse        http.Response
+		expectedSignatureRequest hellosign.SignatureRequest
+		expectedError            error
+	}{
+		"success": {
+			payload: hellosign.SignatureRequestPayload{
+				ClientID: "b6b8e7deaf8f0b95c029dca049356d4a2cf9710a",
+				FileURL:  []string{"https://example.com/agreement.pdf"},
+				Signers: []hellosign.SignerDetail{
+					{Name: "John Doe", EmailAddress: "john@example.com"},
+				},
+			},
+			signatureResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(signatureRequestJSON)),
+				Header:     make(http.Header),
+			},
+			expectedSignatureRequest: signatureRequest,
+			expectedError:            nil,
+		},
+		"missing client id": {
+			payload: hellosign.SignatureRequestPayload{
+				FileURL: []string{"https://example.com/agreement.pdf"},
+				Signers: []hellosign.SignerDetail{
+					{Name: "John Doe", EmailAddress: "john@example.com"},
+				},
+			},
+			expectedSignatureRequest: hellosign.SignatureRequest{},
+			expectedError:            hellosign.ErrMissingClientID,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal("/v3/signature_request/create_embedded", req.URL.Path)
+
+				err := req.ParseMultipartForm(1 << 20)
+				is.NoErr(err)
+				is.Equal(test.payload.ClientID, req.FormValue("client_id"))
+
+				return &test.signatureResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.SignatureRequestAPI.CreateEmbedded(context.TODO(), test.payload)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedSignatureRequest, resp)
+		})
+	}
+}
+
+func TestSignatureRequest_CreateEmbeddedWithTemplate(t *testing.T) {
+	is := is.New(t)
+
+	signatureRequestJSON := testdata.GetGolden(t, "signature-request")
+	signatureRequest := hellosign.SignatureRequest{}
+	err := json.Unmarshal(signatureRequestJSON, &signatureRequest)
+	is.NoErr(err)
+
+	tests := map[string]struct {
+		param                    hellosign.SignatureRequestTemplatePayload
+		signatureResponse        http.Response
+		expectedSignatureRequest hellosign.SignatureRequest
+		expectedError            error
+	}{
+		"success": {
+			param: hellosign.SignatureRequestTemplatePayload{
+				ClientID:    "b6b8e7deaf8f0b95c029dca049356d4a2cf9710a",
+				TemplateIDs: []string{"c26b8a16784a872da37ea946b9ddec7c1e11dff6"},
+				Signers: map[string]hellosign.TemplateSignerDetail{
+					"Client": {Name: "John Doe", EmailAddress: "john@example.com"},
+				},
+			},
+			signatureResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(signatureRequestJSON)),
+				Header:     make(http.Header),
+			},
+			expectedSignatureRequest: signatureRequest,
+			expectedError:            nil,
+		},
+		"missing client id": {
+			param: hellosign.SignatureRequestTemplatePayload{
+				TemplateIDs: []string{"c26b8a16784a872da37ea946b9ddec7c1e11dff6"},
+				Signers: map[string]hellosign.TemplateSignerDetail{
+					"Client": {Name: "John Doe", EmailAddress: "john@example.com"},
+				},
+			},
+			expectedSignatureRequest: hellosign.SignatureRequest{},
+			expectedError:            hellosign.ErrMissingClientID,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal(http.MethodPost, req.Method)
+				is.Equal("/v3/signature_request/create_embedded_with_template", req.URL.Path)
+
+				err := req.ParseMultipartForm(1 << 20)
+				is.NoErr(err)
+				is.Equal(test.param.ClientID, req.FormValue("client_id"))
+				is.Equal(test.param.TemplateIDs[0], req.FormValue("template_ids[0]"))
+
+				return &test.signatureResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.SignatureRequestAPI.CreateEmbeddedWithTemplate(context.TODO(), test.param)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedSignatureRequest, resp)
+		})
+	}
+}
+
+func TestSignatureRequest_BulkSendWithTemplate(t *testing.T) {
+	is := is.New(t)
+
+	bulkSendJob := hellosign.BulkSendJob{
+		BulkSendJob: hellosign.BulkSendJobDetail{
+			BulkSendJobID: "6e683bc0369ba3d5b6f43c2c22a8031dbf6bd174",
+			Total:         2,
+			IsCreator:     true,
+			CreatedAt:     1532640962,
+		},
+	}
+	bulkSendJobJSON, err := json.Marshal(bulkSendJob)
+	is.NoErr(err)
+
+	tests := map[string]struct {
+		payload             hellosign.BulkSendWithTemplatePayload
+		expectedFields      map[string]string
+		expectedSignerFile  string
+		bulkSendJobResponse http.Response
+		expectedBulkSendJob hellosign.BulkSendJob
+		expectedError       error
+	}{
+		"signer list": {
+			payload: hellosign.BulkSendWithTemplatePayload{
+				TemplateIDs: []string{"c26b8a16784a872da37ea946b9ddec7c1e11dff6"},
+				SignerList: []hellosign.BulkSendSignerDetail{
+					{
+						Signers: map[string]hellosign.TemplateSignerDetail{
+							"Candidate": {Name: "George", EmailAddress: "george@example.com"},
+						},
+						CustomFields: []hellosign.CustomFieldsDetail{
+							{Name: "Salary", Value: "$100,000"},
+						},
+					},
+					{
+						Signers: map[string]hellosign.TemplateSignerDetail{
+							"Candidate": {Name: "Mary", EmailAddress: "mary@example.com"},
+						},
+					},
+				},
+			},
+			expectedFields: map[string]string{
+				"template_ids[0]": "c26b8a16784a872da37ea946b9ddec7c1e11dff6",
+				"signer_list":     `[{"signers":{"Candidate":{"name":"George","email_address":"george@example.com"}},"custom_fields":[{"name":"Salary","value":"$100,000"}]},{"signers":{"Candidate":{"name":"Mary","email_address":"mary@example.com"}}}]`,
+			},
+			bulkSendJobResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(bulkSendJobJSON)),
+				Header:     make(http.Header),
+			},
+			expectedBulkSendJob: bulkSendJob,
+			expectedError:       nil,
+		},
+		"signer file": {
+			payload: hellosign.BulkSendWithTemplatePayload{
+				TemplateIDs: []string{"c26b8a16784a872da37ea946b9ddec7c1e11dff6"},
+				SignerFile: &hellosign.File{
+					Name:   "signers.csv",
+					Reader: bytes.NewReader([]byte("Candidate::name,Candidate::email_address\nGeorge,george@example.com\n")),
+				},
+			},
+			expectedFields: map[string]string{
+				"template_ids[0]": "c26b8a16784a872da37ea946b9ddec7c1e11dff6",
+			},
+			expectedSignerFile: "signers.csv",
+			bulkSendJobResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(bulkSendJobJSON)),
+				Header:     make(http.Header),
+			},
+			expectedBulkSendJob: bulkSendJob,
+			expectedError:       nil,
+		},
+		"missing signers": {
+			payload: hellosign.BulkSendWithTemplatePayload{
+				TemplateIDs: []string{"c26b8a16784a872da37ea946b9ddec7c1e11dff6"},
+			},
+			expectedBulkSendJob: hellosign.BulkSendJob{},
+			expectedError:       hellosign.ErrBulkSendSigners,
+		},
+		"metadata limits": {
+			payload: hellosign.BulkSendWithTemplatePayload{
+				TemplateIDs: []string{"c26b8a16784a872da37ea946b9ddec7c1e11dff6"},
+				SignerList: []hellosign.BulkSendSignerDetail{
+					{
+						Signers: map[string]hellosign.TemplateSignerDetail{
+							"Candidate": {Name: "George", EmailAddress: "george@example.com"},
+						},
+					},
+				},
+				Metadata: map[string]interface{}{
+					"note": strings.Repeat("v", 501),
+				},
+			},
+			expectedBulkSendJob: hellosign.BulkSendJob{},
+			expectedError:       errors.New("hellosign: invalid payload: metadata[note]: value must not be longer than 500 characters"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal("/v3/signature_request/bulk_send_with_template", req.URL.Path)
+
+				err := req.ParseMultipartForm(1 << 20)
+				is.NoErr(err)
+				for k, v := range test.expectedFields {
+					is.Equal(v, req.FormValue(k))
+				}
+				if test.expectedSignerFile != "" {
+					is.Equal(test.expectedSignerFile, req.MultipartForm.File["signer_file"][0].Filename)
+				}
+
+				return &test.bulkSendJobResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.SignatureRequestAPI.BulkSendWithTemplate(context.TODO(), test.payload)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedBulkSendJob, resp)
+		})
+	}
+}
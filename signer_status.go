@@ -0,0 +1,117 @@
+package hellosign
+
+import (
+	"fmt"
+)
+
+// SignerStatus is a status code of a signer in a signature request
+type SignerStatus string
+
+const (
+	// SignerStatusAwaitingSignature is status for signer who has not signed yet
+	SignerStatusAwaitingSignature SignerStatus = "awaiting_signature"
+	// SignerStatusSigned is status for signer who has signed
+	SignerStatusSigned SignerStatus = "signed"
+	// SignerStatusDeclined is status for signer who has declined to sign
+	SignerStatusDeclined SignerStatus = "declined"
+	// SignerStatusOnHold is status for signer whose request is on hold, ex: unapproved api app
+	SignerStatusOnHold SignerStatus = "on_hold"
+	// SignerStatusErrorUnknown is status for signer with an unknown error
+	SignerStatusErrorUnknown SignerStatus = "error_unknown"
+	// SignerStatusErrorFile is status for signer with an error in the uploaded file
+	SignerStatusErrorFile SignerStatus = "error_file"
+	// SignerStatusErrorComponentPosition is status for signer with a form field placed outside the document
+	SignerStatusErrorComponentPosition SignerStatus = "error_component_position"
+	// SignerStatusErrorTextTag is status for signer with an invalid text tag in the document
+	SignerStatusErrorTextTag SignerStatus = "error_text_tag"
+)
+
+// signerStatuses is all known signer statuses
+var signerStatuses = map[SignerStatus]bool{
+	SignerStatusAwaitingSignature:      true,
+	SignerStatusSigned:                 true,
+	SignerStatusDeclined:               true,
+	SignerStatusOnHold:                 true,
+	SignerStatusErrorUnknown:           true,
+	SignerStatusErrorFile:              true,
+	SignerStatusErrorComponentPosition: true,
+	SignerStatusErrorTextTag:           true,
+}
+
+// ParseSignerStatus returns signer status of s, or an error if s is not a known status
+func ParseSignerStatus(s string) (SignerStatus, error) {
+	status := SignerStatus(s)
+	err := status.Validate()
+	if err != nil {
+		return "", err
+	}
+	return status, nil
+}
+
+// Validate check if the signer status is a known status
+func (s SignerStatus) Validate() error {
+	if !signerStatuses[s] {
+		return fmt.Errorf("hellosign: unknown signer status %q", string(s))
+	}
+	return nil
+}
+
+// IsPending check if the signer still has to sign
+func (s SignerStatus) IsPending() bool {
+	return s == SignerStatusAwaitingSignature || s == SignerStatusOnHold
+}
+
+// IsError check if the signer status is one of error statuses
+func (s SignerStatus) IsError() bool {
+	switch s {
+	case SignerStatusErrorUnknown, SignerStatusErrorFile, SignerStatusErrorComponentPosition, SignerStatusErrorTextTag:
+		return true
+	}
+	return false
+}
+
+// SignaturesByStatus returns signatures which have one of statuses, in the order of the response
+func (s SignatureRequestDetail) SignaturesByStatus(statuses ...SignerStatus) []SignatureDetail {
+	signatures := []SignatureDetail{}
+	for _, signature := range s.Signatures {
+		for _, status := range statuses {
+			if signature.StatusCode == status {
+				signatures = append(signatures, signature)
+				break
+			}
+		}
+	}
+	return signatures
+}
+
+// PendingSigners returns signatures of signers who still have to sign
+func (s SignatureRequestDetail) PendingSigners() []SignatureDetail {
+	return s.SignaturesByStatus(SignerStatusAwaitingSignature, SignerStatusOnHold)
+}
+
+// NextSigner returns the pending signer with the lowest order.
+// It returns false if there is no pending signer.
+func (s SignatureRequestDetail) NextSigner() (SignatureDetail, bool) {
+	pending := s.PendingSigners()
+	if len(pending) == 0 {
+		return SignatureDetail{}, false
+	}
+
+	next := pending[0]
+	for _, signature := range pending[1:] {
+		if signature.Order < next.Order {
+			next = signature
+		}
+	}
+	return next, true
+}
+
+// DeclinedBy returns the signer who declined the signature request.
+// It returns false if no signer has declined.
+func (s SignatureRequestDetail) DeclinedBy() (SignatureDetail, bool) {
+	declined := s.SignaturesByStatus(SignerStatusDeclined)
+	if len(declined) == 0 {
+		return SignatureDetail{}, false
+	}
+	return declined[0], true
+}
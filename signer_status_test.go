@@ -0,0 +1,75 @@
+package hellosign_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+)
+
+func TestParseSignerStatus(t *testing.T) {
+	tests := map[string]struct {
+		status         string
+		expectedStatus hellosign.SignerStatus
+		expectedError  error
+	}{
+		"signed": {
+			status:         "signed",
+			expectedStatus: hellosign.SignerStatusSigned,
+		},
+		"error text tag": {
+			status:         "error_text_tag",
+			expectedStatus: hellosign.SignerStatusErrorTextTag,
+		},
+		"unknown": {
+			status:        "sent",
+			expectedError: errors.New(`hellosign: unknown signer status "sent"`),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			status, err := hellosign.ParseSignerStatus(test.status)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedStatus, status)
+		})
+	}
+}
+
+func TestSignatureRequestDetail_Signers(t *testing.T) {
+	is := is.New(t)
+
+	detail := hellosign.SignatureRequestDetail{
+		Signatures: []hellosign.SignatureDetail{
+			{SignatureID: "a", Order: 0, StatusCode: hellosign.SignerStatusSigned},
+			{SignatureID: "b", Order: 2, StatusCode: hellosign.SignerStatusAwaitingSignature},
+			{SignatureID: "c", Order: 1, StatusCode: hellosign.SignerStatusAwaitingSignature},
+			{SignatureID: "d", Order: 3, StatusCode: hellosign.SignerStatusErrorFile},
+		},
+	}
+
+	pending := detail.PendingSigners()
+	is.Equal(2, len(pending))
+	is.Equal("b", pending[0].SignatureID)
+	is.Equal("c", pending[1].SignatureID)
+
+	next, ok := detail.NextSigner()
+	is.True(ok)
+	is.Equal("c", next.SignatureID)
+
+	_, ok = detail.DeclinedBy()
+	is.True(!ok)
+	is.True(detail.Signatures[3].StatusCode.IsError())
+
+	detail.Signatures[2].StatusCode = hellosign.SignerStatusDeclined
+	declined, ok := detail.DeclinedBy()
+	is.True(ok)
+	is.Equal("c", declined.SignatureID)
+}
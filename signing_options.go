@@ -0,0 +1,40 @@
+package hellosign
+
+// SigningOption is a way for a signer to create a signature
+type SigningOption string
+
+const (
+	// SigningOptionDraw is drawing the signature
+	SigningOptionDraw SigningOption = "draw"
+	// SigningOptionType is typing the signature
+	SigningOptionType SigningOption = "type"
+	// SigningOptionUpload is uploading an image of the signature
+	SigningOptionUpload SigningOption = "upload"
+	// SigningOptionPhone is drawing the signature on a phone
+	SigningOptionPhone SigningOption = "phone"
+)
+
+// SigningOptions is allowed ways for signers to create their signature.
+// Default must be one of the allowed ways.
+type SigningOptions struct {
+	Draw    bool          `json:"draw"`
+	Type    bool          `json:"type"`
+	Upload  bool          `json:"upload"`
+	Phone   bool          `json:"phone"`
+	Default SigningOption `json:"default"`
+}
+
+// Allows check if signers may create their signature with option
+func (s SigningOptions) Allows(option SigningOption) bool {
+	switch option {
+	case SigningOptionDraw:
+		return s.Draw
+	case SigningOptionType:
+		return s.Type
+	case SigningOptionUpload:
+		return s.Upload
+	case SigningOptionPhone:
+		return s.Phone
+	}
+	return false
+}
@@ -0,0 +1,364 @@
+package hellosign
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// TemplateAPI is a service to template API
+type TemplateAPI service
+
+// Template represent template response
+type Template struct {
+	Template TemplateDetail `json:"template"`
+	Warnings []Warnings     `json:"warnings,omitempty"`
+}
+
+// TemplateList represent list of templates response
+type TemplateList struct {
+	ListInfo  ListInfo         `json:"list_info"`
+	Templates []TemplateDetail `json:"templates"`
+	Warnings  []Warnings       `json:"warnings,omitempty"`
+}
+
+// CheckWarnings check if there are warning messages
+func (t Template) CheckWarnings() bool {
+	return len(t.Warnings) > 0
+}
+
+// TemplateDetail represent detail of a template.
+// CustomFields are the merge fields declared by the template,
+// they are filled with custom fields when sending a signature request.
+type TemplateDetail struct {
+	TemplateID   string                   `json:"template_id"`
+	Title        string                   `json:"title"`
+	Message      string                   `json:"message"`
+	UpdatedAt    UnixTime                 `json:"updated_at"`
+	IsEmbedded   bool                     `json:"is_embedded"`
+	IsCreator    bool                     `json:"is_creator"`
+	CanEdit      bool                     `json:"can_edit"`
+	IsLocked     bool                     `json:"is_locked"`
+	Metadata     Metadata                 `json:"metadata"`
+	SignerRoles  []TemplateRoleDetail     `json:"signer_roles"`
+	CCRoles      []TemplateRoleDetail     `json:"cc_roles"`
+	Documents    []TemplateDocumentDetail `json:"documents"`
+	CustomFields []MergeFieldDetail       `json:"custom_fields"`
+	Accounts     []TemplateAccountDetail  `json:"accounts"`
+}
+
+// TemplateRoleDetail is detail for a signer or cc role of a template, cc roles have no order
+type TemplateRoleDetail struct {
+	Name  string `json:"name"`
+	Order int    `json:"order,omitempty"`
+}
+
+// TemplateDocumentDetail is detail for a document of a template
+type TemplateDocumentDetail struct {
+	Name         string                `json:"name"`
+	Index        int                   `json:"index"`
+	FormFields   []TemplateFieldDetail `json:"form_fields"`
+	CustomFields []TemplateFieldDetail `json:"custom_fields"`
+}
+
+// TemplateFieldDetail is detail for a form field or custom field placed in a template document
+type TemplateFieldDetail struct {
+	APIID    string              `json:"api_id"`
+	Name     string              `json:"name"`
+	Type     string              `json:"type"`
+	X        int                 `json:"x"`
+	Y        int                 `json:"y"`
+	Width    int                 `json:"width"`
+	Height   int                 `json:"height"`
+	Required bool                `json:"required"`
+	Signer   TemplateFieldSigner `json:"signer"`
+	Group    string              `json:"group"`
+}
+
+// TemplateFieldSigner is the signer of a template field, either a signer index or "sender"
+type TemplateFieldSigner string
+
+// UnmarshalJSON decodes the signer from a number or a string
+func (s *TemplateFieldSigner) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*s = ""
+		return nil
+	}
+
+	if len(b) > 0 && b[0] == '"' {
+		var signer string
+		err := json.Unmarshal(b, &signer)
+		if err != nil {
+			return err
+		}
+		*s = TemplateFieldSigner(signer)
+		return nil
+	}
+
+	var signer json.Number
+	err := json.Unmarshal(b, &signer)
+	if err != nil {
+		return err
+	}
+	*s = TemplateFieldSigner(signer)
+	return nil
+}
+
+// TemplateAccountDetail is detail for an account which has access to a template
+type TemplateAccountDetail struct {
+	AccountID    string `json:"account_id"`
+	EmailAddress string `json:"email_address"`
+}
+
+// TemplateListParam is param to list templates
+type TemplateListParam struct {
+	ListInfoQueryParam
+	AccountID string
+	// Query is hellosign search query, use Query() to build it
+	Query string
+}
+
+// TemplateDraftPayload is payload for create a template draft to be edited in an iframe on your site.
+// MergeFields are the fields to be filled with custom fields when the template is used,
+// their type is FieldText or FieldCheckBox.
+type TemplateDraftPayload struct {
+	TestMode    int                    `json:"test_mode"`
+	ClientID    string                 `json:"client_id"`
+	FileURL     []string               `json:"file_url"`
+	Title       string                 `json:"title"`
+	Subject     string                 `json:"subject"`
+	Message     string                 `json:"message"`
+	SignerRoles []TemplateRoleDetail   `json:"signer_roles"`
+	CCRoles     []string               `json:"cc_roles"`
+	MergeFields []MergeFieldDetail     `json:"merge_fields"`
+	SkipMeNow   bool                   `json:"skip_me_now"`
+	Metadata    map[string]interface{} `json:"metadata"`
+}
+
+// TemplateDraft represent embedded template draft response
+type TemplateDraft struct {
+	Template TemplateDraftDetail `json:"template"`
+	Warnings []Warnings          `json:"warnings,omitempty"`
+}
+
+// TemplateDraftDetail represent a template draft and the url to edit it in an iframe
+type TemplateDraftDetail struct {
+	TemplateID string   `json:"template_id"`
+	EditURL    string   `json:"edit_url"`
+	ExpiresAt  UnixTime `json:"expires_at"`
+}
+
+// CheckWarnings check if there are warning messages
+func (t TemplateDraft) CheckWarnings() bool {
+	return len(t.Warnings) > 0
+}
+
+const (
+	// subURLTemplate is sub url path for template
+	subURLTemplate = "/template"
+)
+
+var (
+	// subURLTemplateList is sub url path for list templates
+	subURLTemplateList = subURLTemplate + "/list"
+
+	// subURLTemplateDelete is sub url path for delete a template
+	subURLTemplateDelete = subURLTemplate + "/delete"
+
+	// subURLTemplateCreateEmbeddedDraft is sub url path for create an embedded template draft
+	subURLTemplateCreateEmbeddedDraft = subURLTemplate + "/create_embedded_draft"
+)
+
+// Get will return a template by template id
+// Ref: https://app.hellosign.com/api/reference#get_template
+func (t *TemplateAPI) Get(ctx context.Context, templateID string) (Template, error) {
+	resp, err := t.client.callAPI(
+		ctx,
+		requestParam{
+			path:   t.client.BaseURL + subURLTemplate + "/" + templateID,
+			method: http.MethodGet,
+		},
+	)
+	if err != nil {
+		return Template{}, err
+	}
+	defer resp.Body.Close()
+
+	template := Template{}
+	err = json.NewDecoder(resp.Body).Decode(&template)
+	if err != nil {
+		return Template{}, err
+	}
+
+	return template, nil
+}
+
+// List will return a list of templates which you can access
+// Ref: https://app.hellosign.com/api/reference#list_templates
+func (t *TemplateAPI) List(ctx context.Context, p TemplateListParam) (TemplateList, error) {
+	req, err := t.client.prepareRequest(
+		ctx,
+		requestParam{
+			path:   t.client.BaseURL + subURLTemplateList,
+			method: http.MethodGet,
+		})
+	if err != nil {
+		return TemplateList{}, err
+	}
+
+	q := req.URL.Query()
+	if p.AccountID != "" {
+		q.Add("account_id", p.AccountID)
+	}
+	if p.Query != "" {
+		q.Add("query", p.Query)
+	}
+	p.addQuery(q)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := t.client.executeRequest(req)
+	if err != nil {
+		return TemplateList{}, err
+	}
+	defer resp.Body.Close()
+
+	templateList := TemplateList{}
+	err = json.NewDecoder(resp.Body).Decode(&templateList)
+	if err != nil {
+		return TemplateList{}, err
+	}
+
+	return templateList, nil
+}
+
+// ListAll will return a pager which iterates templates across all pages
+// starting from p.Page
+func (t *TemplateAPI) ListAll(p TemplateListParam) *TemplatePager {
+	fetch := func(ctx context.Context, page ListInfoQueryParam) ([]interface{}, ListInfo, error) {
+		param := p
+		param.ListInfoQueryParam = page
+
+		list, err := t.List(ctx, param)
+		if err != nil {
+			return nil, ListInfo{}, err
+		}
+
+		items := make([]interface{}, 0, len(list.Templates))
+		for _, template := range list.Templates {
+			items = append(items, template)
+		}
+
+		return items, list.ListInfo, nil
+	}
+
+	return &TemplatePager{NewPager(fetch, p.ListInfoQueryParam)}
+}
+
+// Delete will delete a template
+// Ref: https://app.hellosign.com/api/reference#delete_template
+func (t *TemplateAPI) Delete(ctx context.Context, templateID string) error {
+	resp, err := t.client.callAPI(
+		ctx,
+		requestParam{
+			path:   t.client.BaseURL + subURLTemplateDelete + "/" + templateID,
+			method: http.MethodPost,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// form encodes template draft payload into hellosign form syntax,
+// ex: signer_roles[0][name]
+func (p TemplateDraftPayload) form() (form, error) {
+	f := form{}
+	f.addInt("test_mode", p.TestMode)
+	f.add("client_id", p.ClientID)
+	f.addList("file_url", p.FileURL)
+	f.add("title", p.Title)
+	f.add("subject", p.Subject)
+	f.add("message", p.Message)
+
+	ordered := false
+	for _, role := range p.SignerRoles {
+		if role.Order != 0 {
+			ordered = true
+			break
+		}
+	}
+
+	for i, role := range p.SignerRoles {
+		prefix := fmt.Sprintf("signer_roles[%d]", i)
+		f.add(prefix+"[name]", role.Name)
+		if ordered {
+			f.add(prefix+"[order]", strconv.Itoa(role.Order))
+		}
+	}
+
+	f.addList("cc_roles", p.CCRoles)
+
+	if len(p.MergeFields) > 0 {
+		err := f.addJSON("merge_fields", p.MergeFields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f.addBool("skip_me_now", p.SkipMeNow)
+	f.addMetadata(p.Metadata)
+
+	return f, nil
+}
+
+// CreateEmbeddedDraft will create a template draft to be edited in an iframe on your site.
+// files are the documents of the template, use either files or FileURL. Param ClientID is required.
+// The template can be used once the draft is saved in the iframe.
+// Ref: https://app.hellosign.com/api/reference#create_embedded_template_draft
+func (t *TemplateAPI) CreateEmbeddedDraft(ctx context.Context, payload TemplateDraftPayload, files ...File) (TemplateDraft, error) {
+	if payload.ClientID == "" {
+		return TemplateDraft{}, ErrMissingClientID
+	}
+
+	err := payload.Validate(files...)
+	if err != nil {
+		return TemplateDraft{}, err
+	}
+
+	f, err := payload.form()
+	if err != nil {
+		return TemplateDraft{}, err
+	}
+
+	body, writer, err := f.encode(indexedFiles("file", files))
+	if err != nil {
+		return TemplateDraft{}, err
+	}
+
+	resp, err := t.client.callAPI(
+		ctx,
+		requestParam{
+			path:   t.client.BaseURL + subURLTemplateCreateEmbeddedDraft,
+			method: http.MethodPost,
+			body:   body,
+			writer: writer,
+		},
+	)
+	if err != nil {
+		return TemplateDraft{}, err
+	}
+	defer resp.Body.Close()
+
+	templateDraft := TemplateDraft{}
+	err = json.NewDecoder(resp.Body).Decode(&templateDraft)
+	if err != nil {
+		return TemplateDraft{}, err
+	}
+
+	return templateDraft, nil
+}
@@ -0,0 +1,336 @@
+package hellosign_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+	"github.com/milhamhidayat/go-hellosign-sdk/testdata"
+)
+
+func TestTemplate_Get(t *testing.T) {
+	is := is.New(t)
+
+	templateJSON := testdata.GetGolden(t, "template")
+	template := hellosign.Template{}
+	err := json.Unmarshal(templateJSON, &template)
+	is.NoErr(err)
+	is.Equal(2, len(template.Template.SignerRoles))
+	is.Equal(hellosign.TemplateFieldSigner("0"), template.Template.Documents[0].FormFields[0].Signer)
+	is.Equal(hellosign.TemplateFieldSigner("1"), template.Template.Documents[0].FormFields[1].Signer)
+	is.Equal(hellosign.TemplateFieldSigner("sender"), template.Template.Documents[0].CustomFields[0].Signer)
+
+	errNotFoundJSON := testdata.GetGolden(t, "signature-request-err-not-found")
+
+	tests := map[string]struct {
+		templateID       string
+		templateResponse http.Response
+		expectedTemplate hellosign.Template
+		expectedError    error
+	}{
+		"success": {
+			templateID: "f57db65d3f933b5316d398057a36176831451a35",
+			templateResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(templateJSON)),
+				Header:     make(http.Header),
+			},
+			expectedTemplate: template,
+			expectedError:    nil,
+		},
+		"not found": {
+			templateID: "f57db65d3f933b5316d398057a36176831451a35",
+			templateResponse: http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(bytes.NewReader(errNotFoundJSON)),
+				Header:     make(http.Header),
+			},
+			expectedTemplate: hellosign.Template{},
+			expectedError:    errors.New("not_found: Not found"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal(http.MethodGet, req.Method)
+				is.Equal("/v3/template/"+test.templateID, req.URL.Path)
+				return &test.templateResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.TemplateAPI.Get(context.TODO(), test.templateID)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedTemplate, resp)
+		})
+	}
+}
+
+func TestTemplate_List(t *testing.T) {
+	is := is.New(t)
+
+	templateListJSON := testdata.GetGolden(t, "template-list")
+	templateList := hellosign.TemplateList{}
+	err := json.Unmarshal(templateListJSON, &templateList)
+	is.NoErr(err)
+
+	tests := map[string]struct {
+		param                hellosign.TemplateListParam
+		expectedQuery        string
+		templateListResponse http.Response
+		expectedTemplateList hellosign.TemplateList
+		expectedError        error
+	}{
+		"success": {
+			param: hellosign.TemplateListParam{
+				ListInfoQueryParam: hellosign.ListInfoQueryParam{
+					Page:     1,
+					PageSize: 20,
+				},
+				AccountID: "all",
+				Query:     hellosign.Query().Title("NDA").String(),
+			},
+			expectedQuery: "account_id=all&page=1&page_size=20&query=title%3A%22NDA%22",
+			templateListResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(templateListJSON)),
+				Header:     make(http.Header),
+			},
+			expectedTemplateList: templateList,
+			expectedError:        nil,
+		},
+		"without param": {
+			param:         hellosign.TemplateListParam{},
+			expectedQuery: "",
+			templateListResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(templateListJSON)),
+				Header:     make(http.Header),
+			},
+			expectedTemplateList: templateList,
+			expectedError:        nil,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal("/v3/template/list", req.URL.Path)
+				is.Equal(test.expectedQuery, req.URL.RawQuery)
+				return &test.templateListResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.TemplateAPI.List(context.TODO(), test.param)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedTemplateList, resp)
+		})
+	}
+}
+
+func TestTemplate_ListAll(t *testing.T) {
+	is := is.New(t)
+
+	templateList := hellosign.TemplateList{}
+	err := json.Unmarshal(testdata.GetGolden(t, "template-list"), &templateList)
+	is.NoErr(err)
+
+	templateList.ListInfo.NumPages = 2
+	firstPageJSON, err := json.Marshal(templateList)
+	is.NoErr(err)
+
+	templateList.ListInfo.Page = 2
+	secondPageJSON, err := json.Marshal(templateList)
+	is.NoErr(err)
+
+	responses := map[string][]byte{
+		"1": firstPageJSON,
+		"2": secondPageJSON,
+	}
+
+	mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+		is.Equal("all", req.URL.Query().Get("account_id"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(responses[req.URL.Query().Get("page")])),
+			Header:     make(http.Header),
+		}
+	})
+
+	apiClient := hellosign.NewClient("123")
+	apiClient.HTTPClient = mockHTTPClient
+
+	pager := apiClient.TemplateAPI.ListAll(hellosign.TemplateListParam{AccountID: "all"})
+
+	templateIDs := []string{}
+	for pager.Next(context.TODO()) {
+		templateIDs = append(templateIDs, pager.Template().TemplateID)
+	}
+	is.NoErr(pager.Err())
+	is.Equal(4, len(templateIDs))
+	is.Equal(templateList.Templates[0].TemplateID, templateIDs[0])
+	is.Equal(templateList.Templates[1].TemplateID, templateIDs[3])
+}
+
+func TestTemplate_Delete(t *testing.T) {
+	errNotFoundJSON := testdata.GetGolden(t, "signature-request-err-not-found")
+
+	tests := map[string]struct {
+		templateID       string
+		templateResponse http.Response
+		expectedError    error
+	}{
+		"success": {
+			templateID: "f57db65d3f933b5316d398057a36176831451a35",
+			templateResponse: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+				Header:     make(http.Header),
+			},
+			expectedError: nil,
+		},
+		"not found": {
+			templateID: "f57db65d3f933b5316d398057a36176831451a35",
+			templateResponse: http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(bytes.NewReader(errNotFoundJSON)),
+				Header:     make(http.Header),
+			},
+			expectedError: errors.New("not_found: Not found"),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal(http.MethodPost, req.Method)
+				is.Equal("/v3/template/delete/"+test.templateID, req.URL.Path)
+				return &test.templateResponse
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			err := apiClient.TemplateAPI.Delete(context.TODO(), test.templateID)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+		})
+	}
+}
+
+func TestTemplate_CreateEmbeddedDraft(t *testing.T) {
+	is := is.New(t)
+
+	templateDraftJSON := testdata.GetGolden(t, "template-draft")
+	templateDraft := hellosign.TemplateDraft{}
+	err := json.Unmarshal(templateDraftJSON, &templateDraft)
+	is.NoErr(err)
+
+	file := hellosign.File{Name: "nda.pdf", Reader: bytes.NewReader([]byte("%PDF-1.4"))}
+
+	tests := map[string]struct {
+		payload               hellosign.TemplateDraftPayload
+		files                 []hellosign.File
+		expectedFields        map[string]string
+		expectedTemplateDraft hellosign.TemplateDraft
+		expectedError         error
+	}{
+		"success": {
+			payload: hellosign.TemplateDraftPayload{
+				ClientID: "0a6f5a0b1e7a4a8a",
+				Title:    "Mutual NDA",
+				SignerRoles: []hellosign.TemplateRoleDetail{
+					{Name: "Outside Vendor", Order: 0},
+					{Name: "Client", Order: 1},
+				},
+				CCRoles: []string{"Accounting"},
+				MergeFields: []hellosign.MergeFieldDetail{
+					{Name: "Cost", Type: hellosign.FieldText},
+				},
+				SkipMeNow: true,
+			},
+			files: []hellosign.File{file},
+			expectedFields: map[string]string{
+				"client_id":              "0a6f5a0b1e7a4a8a",
+				"title":                  "Mutual NDA",
+				"signer_roles[0][name]":  "Outside Vendor",
+				"signer_roles[0][order]": "0",
+				"signer_roles[1][name]":  "Client",
+				"signer_roles[1][order]": "1",
+				"cc_roles[0]":            "Accounting",
+				"merge_fields":           `[{"name":"Cost","type":"text"}]`,
+				"skip_me_now":            "1",
+			},
+			expectedTemplateDraft: templateDraft,
+		},
+		"missing client id": {
+			payload:       hellosign.TemplateDraftPayload{FileURL: []string{"https://example.com/nda.pdf"}},
+			expectedError: hellosign.ErrMissingClientID,
+		},
+		"invalid merge field": {
+			payload: hellosign.TemplateDraftPayload{
+				ClientID: "0a6f5a0b1e7a4a8a",
+				FileURL:  []string{"https://example.com/nda.pdf"},
+				MergeFields: []hellosign.MergeFieldDetail{
+					{Name: "Cost", Type: "number"},
+				},
+			},
+			expectedError: errors.New(`hellosign: invalid payload: merge_fields[0][type]: must be "text" or "checkbox"`),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+				is.Equal(http.MethodPost, req.Method)
+				is.Equal("/v3/template/create_embedded_draft", req.URL.Path)
+
+				err := req.ParseMultipartForm(1 << 20)
+				is.NoErr(err)
+				for k, v := range test.expectedFields {
+					is.Equal(v, req.FormValue(k))
+				}
+				is.Equal(len(test.files), len(req.MultipartForm.File))
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(bytes.NewReader(templateDraftJSON)),
+					Header:     make(http.Header),
+				}
+			})
+
+			apiClient := hellosign.NewClient("123")
+			apiClient.HTTPClient = mockHTTPClient
+			resp, err := apiClient.TemplateAPI.CreateEmbeddedDraft(context.TODO(), test.payload, test.files...)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedTemplateDraft, resp)
+		})
+	}
+}
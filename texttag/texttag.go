@@ -0,0 +1,262 @@
+// Package texttag renders and parses hellosign text tags.
+// Text tags are placed in a document instead of form fields when the signature request
+// is sent with UseTextTags, ex: [sig|req|signer1].
+// Ref: https://app.hellosign.com/api/textTagsWalkthrough
+package texttag
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+)
+
+// Type is a text tag field type
+type Type string
+
+const (
+	// Signature is signature text tag type
+	Signature Type = "sig"
+	// Initials is initials text tag type
+	Initials Type = "initial"
+	// Text is text text tag type
+	Text Type = "text"
+	// Checkbox is checkbox text tag type
+	Checkbox Type = "check"
+	// DateSigned is date signed text tag type
+	DateSigned Type = "date"
+	// TextMerge is text merge text tag type, filled by the sender with a custom field
+	TextMerge Type = "text-merge"
+	// CheckboxMerge is checkbox merge text tag type, filled by the sender with a custom field
+	CheckboxMerge Type = "checkbox-merge"
+)
+
+const (
+	// required is text tag value for a required field
+	required = "req"
+	// notRequired is text tag value for an optional field
+	notRequired = "noreq"
+	// sender is text tag signer for merge fields
+	sender = "sender"
+	// signerPrefix is text tag signer prefix, followed by signer number starting from 1
+	signerPrefix = "signer"
+)
+
+// types is all known text tag types
+var types = map[Type]bool{
+	Signature:     true,
+	Initials:      true,
+	Text:          true,
+	Checkbox:      true,
+	DateSigned:    true,
+	TextMerge:     true,
+	CheckboxMerge: true,
+}
+
+// validations is all known text field validation types
+var validations = map[string]bool{
+	hellosign.FieldValidationNumbersOnly:                  true,
+	hellosign.FieldValidationLettersOnly:                  true,
+	hellosign.FieldValidationPhoneNumber:                  true,
+	hellosign.FieldValidationBankRoutingNumber:            true,
+	hellosign.FieldValidationBankAccountNumber:            true,
+	hellosign.FieldValidationEmailAddress:                 true,
+	hellosign.FieldValidationZipCode:                      true,
+	hellosign.FieldValidationSocialSecurityNumber:         true,
+	hellosign.FieldValidationEmployerIdentificationNumber: true,
+}
+
+// tagPattern matches a bracket which contains a pipe, ex: [sig|req|signer1]
+var tagPattern = regexp.MustCompile(`\[[^\[\]]*\|[^\[\]]*\]`)
+
+// Tag is a text tag field
+type Tag struct {
+	Type     Type
+	Required bool
+	// Signer is the index of the signer in SignatureRequestPayload.Signers.
+	// It is ignored for merge fields, which are always filled by the sender.
+	Signer int
+	// Label is the name of the field shown to the signer
+	Label string
+	// ID is the api id of the field, for merge fields it is the merge field name
+	ID string
+	// Validation is one of hellosign.FieldValidation constants, only for text field
+	Validation string
+}
+
+// IsMerge check if the tag is filled by the sender with a custom field
+func (t Tag) IsMerge() bool {
+	return t.Type == TextMerge || t.Type == CheckboxMerge
+}
+
+// Validate check if the tag can be rendered into a valid text tag
+func (t Tag) Validate() error {
+	if !types[t.Type] {
+		return fmt.Errorf("texttag: unknown type %q", string(t.Type))
+	}
+	if !t.IsMerge() && t.Signer < 0 {
+		return fmt.Errorf("texttag: signer index must not be negative")
+	}
+	if t.IsMerge() && t.ID == "" {
+		return fmt.Errorf("texttag: merge field name is required for %s", t.Type)
+	}
+	if t.Validation != "" {
+		if t.Type != Text {
+			return fmt.Errorf("texttag: only %s can have validation", Text)
+		}
+		if !validations[t.Validation] {
+			return fmt.Errorf("texttag: unknown validation %q", t.Validation)
+		}
+	}
+	for _, v := range []string{t.Label, t.ID} {
+		if strings.ContainsAny(v, "|[]") {
+			return fmt.Errorf("texttag: %q must not contain |, [ or ]", v)
+		}
+	}
+	return nil
+}
+
+// String returns the text tag without validating it, ex: [text|req|signer1|Name|name]
+func (t Tag) String() string {
+	parts := []string{string(t.Type), notRequired}
+	if t.Required {
+		parts[1] = required
+	}
+
+	if t.IsMerge() {
+		parts = append(parts, sender)
+	} else {
+		parts = append(parts, signerPrefix+strconv.Itoa(t.Signer+1))
+	}
+
+	optional := []string{t.Label, t.ID, t.Validation}
+	last := len(optional)
+	for last > 0 && optional[last-1] == "" {
+		last--
+	}
+	parts = append(parts, optional[:last]...)
+
+	return "[" + strings.Join(parts, "|") + "]"
+}
+
+// Render validates the tag and returns its text tag
+func Render(t Tag) (string, error) {
+	err := t.Validate()
+	if err != nil {
+		return "", err
+	}
+	return t.String(), nil
+}
+
+// ParsedTag is a text tag found in a text
+type ParsedTag struct {
+	Tag
+	// Offset is the byte offset of the tag in the text
+	Offset int
+	// Raw is the text tag as written in the text
+	Raw string
+}
+
+// ParseError is an invalid text tag found in a text
+type ParseError struct {
+	Offset int
+	Raw    string
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("offset %d: %s: %v", e.Offset, e.Raw, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseErrors is a list of invalid text tags found in a text
+type ParseErrors []*ParseError
+
+func (e ParseErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return "texttag: invalid text tags: " + strings.Join(messages, "; ")
+}
+
+// Parse returns every valid text tag in text.
+// A bracket is treated as a text tag when it contains a pipe, invalid ones are returned as ParseErrors.
+func Parse(text string) ([]ParsedTag, error) {
+	tags := []ParsedTag{}
+	errs := ParseErrors{}
+
+	for _, loc := range tagPattern.FindAllStringIndex(text, -1) {
+		raw := text[loc[0]:loc[1]]
+		tag, err := parseTag(raw)
+		if err != nil {
+			errs = append(errs, &ParseError{Offset: loc[0], Raw: raw, Err: err})
+			continue
+		}
+		tags = append(tags, ParsedTag{Tag: tag, Offset: loc[0], Raw: raw})
+	}
+
+	if len(errs) > 0 {
+		return tags, errs
+	}
+	return tags, nil
+}
+
+// parseTag parses a single text tag including its brackets
+func parseTag(raw string) (Tag, error) {
+	parts := strings.Split(strings.TrimSpace(raw[1:len(raw)-1]), "|")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+
+	if len(parts) < 3 {
+		return Tag{}, fmt.Errorf("texttag: type, required and signer are required")
+	}
+	if len(parts) > 6 {
+		return Tag{}, fmt.Errorf("texttag: too many parts")
+	}
+
+	tag := Tag{Type: Type(parts[0])}
+
+	switch parts[1] {
+	case required:
+		tag.Required = true
+	case notRequired:
+	default:
+		return Tag{}, fmt.Errorf("texttag: required must be %s or %s", required, notRequired)
+	}
+
+	signer := parts[2]
+	if signer == sender {
+		if !tag.IsMerge() {
+			return Tag{}, fmt.Errorf("texttag: only merge fields can be filled by %s", sender)
+		}
+	} else {
+		if tag.IsMerge() {
+			return Tag{}, fmt.Errorf("texttag: merge fields must be filled by %s", sender)
+		}
+		number, err := strconv.Atoi(strings.TrimPrefix(signer, signerPrefix))
+		if err != nil || !strings.HasPrefix(signer, signerPrefix) || number < 1 {
+			return Tag{}, fmt.Errorf("texttag: invalid signer %q", signer)
+		}
+		tag.Signer = number - 1
+	}
+
+	optional := append(parts[3:], "", "", "")
+	tag.Label = optional[0]
+	tag.ID = optional[1]
+	tag.Validation = optional[2]
+
+	err := tag.Validate()
+	if err != nil {
+		return Tag{}, err
+	}
+
+	return tag, nil
+}
@@ -0,0 +1,103 @@
+package texttag_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+	"github.com/milhamhidayat/go-hellosign-sdk/texttag"
+)
+
+func TestRender(t *testing.T) {
+	tests := map[string]struct {
+		tag           texttag.Tag
+		expectedTag   string
+		expectedError error
+	}{
+		"signature": {
+			tag:         texttag.Tag{Type: texttag.Signature, Required: true},
+			expectedTag: "[sig|req|signer1]",
+		},
+		"text with validation": {
+			tag: texttag.Tag{
+				Type:       texttag.Text,
+				Signer:     1,
+				Label:      "Zip Code",
+				ID:         "zip",
+				Validation: hellosign.FieldValidationZipCode,
+			},
+			expectedTag: "[text|noreq|signer2|Zip Code|zip|zip_code]",
+		},
+		"text merge": {
+			tag:         texttag.Tag{Type: texttag.TextMerge, Required: true, Signer: 3, ID: "salary"},
+			expectedTag: "[text-merge|req|sender||salary]",
+		},
+		"merge without name": {
+			tag:           texttag.Tag{Type: texttag.CheckboxMerge},
+			expectedError: errors.New("texttag: merge field name is required for checkbox-merge"),
+		},
+		"validation on checkbox": {
+			tag:           texttag.Tag{Type: texttag.Checkbox, Validation: hellosign.FieldValidationNumbersOnly},
+			expectedError: errors.New("texttag: only text can have validation"),
+		},
+		"label with pipe": {
+			tag:           texttag.Tag{Type: texttag.Text, Label: "a|b"},
+			expectedError: errors.New(`texttag: "a|b" must not contain |, [ or ]`),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+			tag, err := texttag.Render(test.tag)
+			if test.expectedError != nil {
+				is.Equal(test.expectedError.Error(), err.Error())
+				return
+			}
+			is.NoErr(err)
+			is.Equal(test.expectedTag, tag)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	is := is.New(t)
+
+	text := `Employee: [text|req|signer1|Name|name]
+See [appendix A] for details.
+Salary: [text-merge|noreq|sender||salary]
+Sign here: [sig|req|signer2]
+Date: [date|req|signerX]
+Agree: [check|maybe|signer1]`
+
+	tags, err := texttag.Parse(text)
+	is.Equal([]texttag.ParsedTag{
+		{
+			Tag:    texttag.Tag{Type: texttag.Text, Required: true, Signer: 0, Label: "Name", ID: "name"},
+			Offset: 10,
+			Raw:    "[text|req|signer1|Name|name]",
+		},
+		{
+			Tag:    texttag.Tag{Type: texttag.TextMerge, ID: "salary"},
+			Offset: 77,
+			Raw:    "[text-merge|noreq|sender||salary]",
+		},
+		{
+			Tag:    texttag.Tag{Type: texttag.Signature, Required: true, Signer: 1},
+			Offset: 122,
+			Raw:    "[sig|req|signer2]",
+		},
+	}, tags)
+
+	var parseErrors texttag.ParseErrors
+	is.True(errors.As(err, &parseErrors))
+	is.Equal(2, len(parseErrors))
+	is.Equal(`offset 146: [date|req|signerX]: texttag: invalid signer "signerX"`, parseErrors[0].Error())
+	is.Equal("[check|maybe|signer1]", parseErrors[1].Raw)
+
+	for _, tag := range tags {
+		is.Equal(tag.Raw, tag.String())
+	}
+}
@@ -0,0 +1,57 @@
+package hellosign
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// UnixTime is a unix timestamp in seconds as returned by hellosign.
+// Zero value means the time is not set, it is decoded from null or 0 and encoded as null.
+type UnixTime int64
+
+// NewUnixTime returns unix time of t, zero time returns unset unix time
+func NewUnixTime(t time.Time) UnixTime {
+	if t.IsZero() {
+		return 0
+	}
+	return UnixTime(t.Unix())
+}
+
+// IsZero check if the time is not set
+func (u UnixTime) IsZero() bool {
+	return u == 0
+}
+
+// Time returns the time in UTC, or zero time if it is not set
+func (u UnixTime) Time() time.Time {
+	if u.IsZero() {
+		return time.Time{}
+	}
+	return time.Unix(int64(u), 0).UTC()
+}
+
+// MarshalJSON encodes the time as unix timestamp, or null if it is not set
+func (u UnixTime) MarshalJSON() ([]byte, error) {
+	if u.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(int64(u), 10)), nil
+}
+
+// UnmarshalJSON decodes unix timestamp, null is decoded as unset time
+func (u *UnixTime) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*u = 0
+		return nil
+	}
+
+	var timestamp int64
+	err := json.Unmarshal(b, &timestamp)
+	if err != nil {
+		return err
+	}
+
+	*u = UnixTime(timestamp)
+	return nil
+}
@@ -0,0 +1,76 @@
+package hellosign_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+	"github.com/milhamhidayat/go-hellosign-sdk/testdata"
+)
+
+func TestUnixTime_JSON(t *testing.T) {
+	tests := map[string]struct {
+		json             string
+		expectedUnixTime hellosign.UnixTime
+		expectedTime     time.Time
+		expectedJSON     string
+	}{
+		"timestamp": {
+			json:             "1346521550",
+			expectedUnixTime: 1346521550,
+			expectedTime:     time.Date(2012, time.September, 1, 17, 45, 50, 0, time.UTC),
+			expectedJSON:     "1346521550",
+		},
+		"null": {
+			json:             "null",
+			expectedUnixTime: 0,
+			expectedTime:     time.Time{},
+			expectedJSON:     "null",
+		},
+		"zero": {
+			json:             "0",
+			expectedUnixTime: 0,
+			expectedTime:     time.Time{},
+			expectedJSON:     "null",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+
+			var unixTime hellosign.UnixTime
+			err := json.Unmarshal([]byte(test.json), &unixTime)
+			is.NoErr(err)
+			is.Equal(test.expectedUnixTime, unixTime)
+			is.Equal(test.expectedTime, unixTime.Time())
+			is.Equal(test.expectedUnixTime, hellosign.NewUnixTime(unixTime.Time()))
+
+			b, err := json.Marshal(unixTime)
+			is.NoErr(err)
+			is.Equal(test.expectedJSON, string(b))
+		})
+	}
+}
+
+func TestSignatureRequestDetail_TimeToSign(t *testing.T) {
+	is := is.New(t)
+
+	signatureRequest := hellosign.SignatureRequest{}
+	err := json.Unmarshal(testdata.GetGolden(t, "signature-request"), &signatureRequest)
+	is.NoErr(err)
+
+	detail := signatureRequest.SignatureRequest
+	detail.CreatedAt = 1346521250
+
+	duration, ok := detail.TimeToSign("78caf2a1d01cd39cea2bc1cbb340dac3")
+	is.True(ok)
+	is.Equal(5*time.Minute, duration)
+	is.True(detail.Signatures[0].LastRemindedAt.IsZero())
+
+	_, ok = detail.TimeToSign("unknown")
+	is.True(!ok)
+}
@@ -0,0 +1,319 @@
+package hellosign
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// maxMetadataKeys is maximum number of metadata keys in a signature request
+	maxMetadataKeys = 10
+
+	// maxMetadataKeyLength is maximum characters of a metadata key
+	maxMetadataKeyLength = 40
+
+	// maxMetadataValueLength is maximum characters of a metadata value
+	maxMetadataValueLength = 500
+
+	// minPinLength is minimum characters of a signer access code
+	minPinLength = 4
+
+	// maxPinLength is maximum characters of a signer access code
+	maxPinLength = 12
+)
+
+// e164Pattern matches a phone number in E.164 format, ex: +14155550100
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// ValidationError is an invalid field of a payload.
+// Field is the path of the field in hellosign form syntax, ex: signers[1][order]
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationErrors is a list of invalid fields of a payload
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return "hellosign: invalid payload: " + strings.Join(messages, "; ")
+}
+
+// add appends a validation error for field
+func (e *ValidationErrors) add(field, format string, args ...interface{}) {
+	*e = append(*e, ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// err returns nil when there is no validation error
+func (e ValidationErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Validate check the payload against hellosign limits before sending it.
+// files are the documents which will be uploaded along with the payload.
+// It returns ValidationErrors which contains every invalid field.
+func (p SignatureRequestPayload) Validate(files ...File) error {
+	errs := ValidationErrors{}
+
+	if len(p.FileURL) > 0 && len(files) > 0 {
+		errs.add("file_url", "cannot be used together with file")
+	}
+	if len(p.FileURL) == 0 && len(files) == 0 {
+		errs.add("file", "file or file_url is required")
+	}
+
+	validateMetadata(&errs, p.Metadata)
+	validateExpiresAt(&errs, p.ExpiresAt)
+
+	if len(p.Signers) == 0 {
+		errs.add("signers", "at least one signer is required")
+	}
+
+	ordered := false
+	for _, signer := range p.Signers {
+		if signer.Order != 0 {
+			ordered = true
+			break
+		}
+	}
+
+	orders := map[int]bool{}
+	for i, signer := range p.Signers {
+		field := fmt.Sprintf("signers[%d]", i)
+		if signer.Group == "" && len(signer.Members) == 0 {
+			if signer.Name == "" {
+				errs.add(field+"[name]", "is required")
+			}
+			if signer.EmailAddress == "" {
+				errs.add(field+"[email_address]", "is required")
+			}
+		} else {
+			validateSignerGroup(&errs, field, signer)
+		}
+		validateSignerAuthentication(&errs, field, signer.Pin, signer.SMSPhoneNumber, signer.SMSPhoneNumberType)
+
+		if !ordered {
+			continue
+		}
+		if signer.Order < 0 || signer.Order >= len(p.Signers) {
+			errs.add(field+"[order]", "must be between 0 and %d", len(p.Signers)-1)
+			continue
+		}
+		if orders[signer.Order] {
+			errs.add(field+"[order]", "order %d is used by another signer", signer.Order)
+		}
+		orders[signer.Order] = true
+	}
+
+	for i, document := range p.FormFieldsPerDocument {
+		for j, formField := range document {
+			field := fmt.Sprintf("form_fields_per_document[%d][%d]", i, j)
+			if formField.Signer < 0 || formField.Signer >= len(p.Signers) {
+				errs.add(field+"[signer]", "signer index %d does not exist", formField.Signer)
+			}
+			if formField.Required && (formField.Width <= 0 || formField.Height <= 0) {
+				errs.add(field, "required field must have width and height")
+			}
+		}
+	}
+
+	if p.SigningOptions != nil && !p.SigningOptions.Allows(p.SigningOptions.Default) {
+		errs.add("signing_options[default]", "default %q must be one of allowed signing options", string(p.SigningOptions.Default))
+	}
+
+	if p.FieldOptions.DateFormat != "" {
+		err := p.FieldOptions.DateFormat.Validate()
+		if err != nil {
+			errs.add("field_options[date_format]", "unknown date format %q", string(p.FieldOptions.DateFormat))
+		}
+	}
+
+	return errs.err()
+}
+
+// Validate check the template payload against hellosign limits before sending it.
+// It returns ValidationErrors which contains every invalid field.
+func (p SignatureRequestTemplatePayload) Validate() error {
+	errs := ValidationErrors{}
+
+	if len(p.TemplateIDs) == 0 {
+		errs.add("template_ids", "at least one template id is required")
+	}
+
+	validateMetadata(&errs, p.Metadata)
+	validateExpiresAt(&errs, p.ExpiresAt)
+	validateTemplateSigners(&errs, "signers", p.Signers)
+
+	return errs.err()
+}
+
+// Validate check the bulk send payload against hellosign limits before sending it.
+// It returns ErrBulkSendSigners when none or both of SignerFile and SignerList are set,
+// otherwise ValidationErrors which contains every invalid field.
+func (p BulkSendWithTemplatePayload) Validate() error {
+	if (p.SignerFile == nil) == (len(p.SignerList) == 0) {
+		return ErrBulkSendSigners
+	}
+
+	errs := ValidationErrors{}
+
+	if len(p.TemplateIDs) == 0 {
+		errs.add("template_ids", "at least one template id is required")
+	}
+
+	validateMetadata(&errs, p.Metadata)
+
+	for i, signers := range p.SignerList {
+		validateTemplateSigners(&errs, fmt.Sprintf("signer_list[%d][signers]", i), signers.Signers)
+	}
+
+	return errs.err()
+}
+
+// validateTemplateSigners check that each signer keyed by template role has a name and email address
+func validateTemplateSigners(errs *ValidationErrors, field string, signers map[string]TemplateSignerDetail) {
+	roles := make([]string, 0, len(signers))
+	for role := range signers {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	for _, role := range roles {
+		signer := signers[role]
+		signerField := field + "[" + role + "]"
+		if signer.Name == "" {
+			errs.add(signerField+"[name]", "is required")
+		}
+		if signer.EmailAddress == "" {
+			errs.add(signerField+"[email_address]", "is required")
+		}
+		validateSignerAuthentication(errs, signerField, signer.Pin, signer.SMSPhoneNumber, signer.SMSPhoneNumberType)
+	}
+}
+
+// validateSignerGroup check that a signer group has a name and members
+func validateSignerGroup(errs *ValidationErrors, field string, signer SignerDetail) {
+	if signer.Group == "" {
+		errs.add(field+"[group]", "group name is required for group members")
+	}
+	if len(signer.Members) == 0 {
+		errs.add(field, "signer group must have at least one member")
+	}
+	for j, member := range signer.Members {
+		memberField := fmt.Sprintf("%s[%d]", field, j)
+		if member.Name == "" {
+			errs.add(memberField+"[name]", "is required")
+		}
+		if member.EmailAddress == "" {
+			errs.add(memberField+"[email_address]", "is required")
+		}
+	}
+}
+
+// validateSignerAuthentication check signer access code and sms phone number
+func validateSignerAuthentication(errs *ValidationErrors, field, pin, phoneNumber string, phoneNumberType SMSPhoneNumberType) {
+	if pin != "" {
+		length := utf8.RuneCountInString(pin)
+		if length < minPinLength || length > maxPinLength {
+			errs.add(field+"[pin]", "must be %d to %d characters", minPinLength, maxPinLength)
+		}
+	}
+
+	if phoneNumber != "" && !e164Pattern.MatchString(phoneNumber) {
+		errs.add(field+"[sms_phone_number]", "must be in E.164 format, ex: +14155550100")
+	}
+
+	switch phoneNumberType {
+	case "":
+	case SMSPhoneNumberTypeAuthentication, SMSPhoneNumberTypeDelivery:
+		if phoneNumber == "" {
+			errs.add(field+"[sms_phone_number_type]", "requires sms_phone_number")
+		}
+	default:
+		errs.add(field+"[sms_phone_number_type]", "unknown type %q", string(phoneNumberType))
+	}
+}
+
+// Validate check the template draft payload against hellosign limits before sending it.
+// files are the documents which will be uploaded along with the payload.
+// It returns ValidationErrors which contains every invalid field.
+func (p TemplateDraftPayload) Validate(files ...File) error {
+	errs := ValidationErrors{}
+
+	if len(p.FileURL) > 0 && len(files) > 0 {
+		errs.add("file_url", "cannot be used together with file")
+	}
+	if len(p.FileURL) == 0 && len(files) == 0 {
+		errs.add("file", "file or file_url is required")
+	}
+
+	validateMetadata(&errs, p.Metadata)
+
+	for i, role := range p.SignerRoles {
+		if role.Name == "" {
+			errs.add(fmt.Sprintf("signer_roles[%d][name]", i), "is required")
+		}
+	}
+
+	names := map[string]bool{}
+	for i, mergeField := range p.MergeFields {
+		field := fmt.Sprintf("merge_fields[%d]", i)
+		if mergeField.Name == "" {
+			errs.add(field+"[name]", "is required")
+		} else if names[mergeField.Name] {
+			errs.add(field+"[name]", "merge field %q is declared more than once", mergeField.Name)
+		}
+		names[mergeField.Name] = true
+
+		if mergeField.Type != FieldText && mergeField.Type != FieldCheckBox {
+			errs.add(field+"[type]", "must be %q or %q", FieldText, FieldCheckBox)
+		}
+	}
+
+	return errs.err()
+}
+
+// validateExpiresAt check that a set expiration time is in the future
+func validateExpiresAt(errs *ValidationErrors, expiresAt UnixTime) {
+	if !expiresAt.IsZero() && !expiresAt.Time().After(time.Now()) {
+		errs.add("expires_at", "must be in the future")
+	}
+}
+
+// validateMetadata check metadata against hellosign metadata limits
+func validateMetadata(errs *ValidationErrors, metadata map[string]interface{}) {
+	if len(metadata) > maxMetadataKeys {
+		errs.add("metadata", "must not have more than %d keys", maxMetadataKeys)
+	}
+
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		field := "metadata[" + k + "]"
+		if utf8.RuneCountInString(k) > maxMetadataKeyLength {
+			errs.add(field, "key must not be longer than %d characters", maxMetadataKeyLength)
+		}
+		if utf8.RuneCountInString(fmt.Sprint(metadata[k])) > maxMetadataValueLength {
+			errs.add(field, "value must not be longer than %d characters", maxMetadataValueLength)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package hellosign_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+)
+
+func TestSignatureRequestPayload_Validate(t *testing.T) {
+	file := hellosign.File{Name: "agreement.pdf", Reader: bytes.NewReader([]byte("%PDF-1.4"))}
+
+	tests := map[string]struct {
+		payload        hellosign.SignatureRequestPayload
+		files          []hellosign.File
+		expectedFields []string
+	}{
+		"valid": {
+			payload: hellosign.SignatureRequestPayload{
+				Signers: []hellosign.SignerDetail{
+					{Name: "John Doe", EmailAddress: "john@example.com", Order: 1},
+					{Name: "Jane Doe", EmailAddress: "jane@example.com", Order: 0},
+				},
+				Metadata: map[string]interface{}{"employee_id": 1234},
+				FormFieldsPerDocument: [][]hellosign.FormFieldDetail{
+					{
+						{Type: hellosign.FieldSignature, Width: 120, Height: 30, Required: true, Signer: 1},
+					},
+				},
+			},
+			files:          []hellosign.File{file},
+			expectedFields: nil,
+		},
+		"file url and file": {
+			payload: hellosign.SignatureRequestPayload{
+				FileURL: []string{"https://example.com/agreement.pdf"},
+				Signers: []hellosign.SignerDetail{
+					{Name: "John Doe", EmailAddress: "john@example.com"},
+				},
+			},
+			files:          []hellosign.File{file},
+			expectedFields: []string{"file_url"},
+		},
+		"missing file and signers": {
+			payload:        hellosign.SignatureRequestPayload{},
+			expectedFields: []string{"file", "signers"},
+		},
+		"metadata limits": {
+			payload: hellosign.SignatureRequestPayload{
+				Signers: []hellosign.SignerDetail{
+					{Name: "John Doe", EmailAddress: "john@example.com"},
+				},
+				Metadata: map[string]interface{}{
+					strings.Repeat("k", 41): "value",
+					"note":                  strings.Repeat("v", 501),
+					"a":                     1, "b": 2, "c": 3, "d": 4, "e": 5, "f": 6, "g": 7, "h": 8, "i": 9,
+				},
+			},
+			files: []hellosign.File{file},
+			expectedFields: []string{
+				"metadata",
+				"metadata[" + strings.Repeat("k", 41) + "]",
+				"metadata[note]",
+			},
+		},
+		"expired": {
+			payload: hellosign.SignatureRequestPayload{
+				Signers: []hellosign.SignerDetail{
+					{Name: "John Doe", EmailAddress: "john@example.com"},
+				},
+				ExpiresAt: hellosign.NewUnixTime(time.Now().Add(-time.Hour)),
+			},
+			files:          []hellosign.File{file},
+			expectedFields: []string{"expires_at"},
+		},
+		"signer order not contiguous": {
+			payload: hellosign.SignatureRequestPayload{
+				Signers: []hellosign.SignerDetail{
+					{Name: "John Doe", EmailAddress: "john@example.com", Order: 0},
+					{Name: "Jane Doe", EmailAddress: "jane@example.com", Order: 2},
+					{Name: "Jack Doe", EmailAddress: "jack@example.com", Order: 2},
+				},
+			},
+			files:          []hellosign.File{file},
+			expectedFields: []string{"signers[2][order]"},
+		},
+		"invalid form fields": {
+			payload: hellosign.SignatureRequestPayload{
+				Signers: []hellosign.SignerDetail{
+					{Name: "John Doe"},
+				},
+				FormFieldsPerDocument: [][]hellosign.FormFieldDetail{
+					{
+						{Type: hellosign.FieldText, Width: 120, Height: 30, Signer: 0},
+						{Type: hellosign.FieldSignature, Required: true, Signer: 1},
+					},
+				},
+			},
+			files: []hellosign.File{file},
+			expectedFields: []string{
+				"signers[0][email_address]",
+				"form_fields_per_document[0][1][signer]",
+				"form_fields_per_document[0][1]",
+			},
+		},
+		"signing options and date format": {
+			payload: hellosign.SignatureRequestPayload{
+				Signers: []hellosign.SignerDetail{
+					{Name: "John Doe", EmailAddress: "john@example.com"},
+				},
+				SigningOptions: &hellosign.SigningOptions{
+					Draw:    true,
+					Default: hellosign.SigningOptionUpload,
+				},
+				FieldOptions: hellosign.FieldOptionsDetail{
+					DateFormat: "MM / DD/ YYYY",
+				},
+			},
+			files: []hellosign.File{file},
+			expectedFields: []string{
+				"signing_options[default]",
+				"field_options[date_format]",
+			},
+		},
+		"signer authentication and group": {
+			payload: hellosign.SignatureRequestPayload{
+				Signers: []hellosign.SignerDetail{
+					{Name: "John Doe", EmailAddress: "john@example.com", Pin: "0042", SMSPhoneNumber: "+14155550100", SMSPhoneNumberType: hellosign.SMSPhoneNumberTypeAuthentication},
+					{Name: "Jane Doe", EmailAddress: "jane@example.com", Pin: "042", SMSPhoneNumber: "4155550100"},
+					{Name: "Jack Doe", EmailAddress: "jack@example.com", SMSPhoneNumberType: "email"},
+					{Group: "Legal", Members: []hellosign.SignerGroupDetail{{Name: "Ann", EmailAddress: "ann@example.com"}, {Name: "Bob"}}},
+					{Members: []hellosign.SignerGroupDetail{{Name: "Cid", EmailAddress: "cid@example.com"}}},
+				},
+			},
+			files: []hellosign.File{file},
+			expectedFields: []string{
+				"signers[1][pin]",
+				"signers[1][sms_phone_number]",
+				"signers[2][sms_phone_number_type]",
+				"signers[3][1][email_address]",
+				"signers[4][group]",
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			is := is.New(t)
+
+			err := test.payload.Validate(test.files...)
+			if test.expectedFields == nil {
+				is.NoErr(err)
+				return
+			}
+
+			var validationErrors hellosign.ValidationErrors
+			is.True(errors.As(err, &validationErrors))
+
+			fields := []string{}
+			for _, validationError := range validationErrors {
+				fields = append(fields, validationError.Field)
+			}
+			is.Equal(test.expectedFields, fields)
+		})
+	}
+}
+
+func TestSignatureRequestTemplatePayload_Validate(t *testing.T) {
+	is := is.New(t)
+
+	payload := hellosign.SignatureRequestTemplatePayload{
+		Signers: map[string]hellosign.TemplateSignerDetail{
+			"Client":  {Name: "John Doe", EmailAddress: "john@example.com", Pin: "123456789012345"},
+			"Witness": {Name: "Jane Doe", SMSPhoneNumber: "+442071838750", SMSPhoneNumberType: hellosign.SMSPhoneNumberTypeDelivery},
+		},
+	}
+
+	err := payload.Validate()
+
+	var validationErrors hellosign.ValidationErrors
+	is.True(errors.As(err, &validationErrors))
+
+	fields := []string{}
+	for _, validationError := range validationErrors {
+		fields = append(fields, validationError.Field)
+	}
+	is.Equal([]string{
+		"template_ids",
+		"signers[Client][pin]",
+		"signers[Witness][email_address]",
+	}, fields)
+}
+
+func TestBulkSendWithTemplatePayload_Validate(t *testing.T) {
+	is := is.New(t)
+
+	err := hellosign.BulkSendWithTemplatePayload{}.Validate()
+	is.Equal(hellosign.ErrBulkSendSigners, err)
+
+	payload := hellosign.BulkSendWithTemplatePayload{
+		SignerList: []hellosign.BulkSendSignerDetail{
+			{
+				Signers: map[string]hellosign.TemplateSignerDetail{
+					"Candidate": {Name: "George", EmailAddress: "george@example.com"},
+				},
+			},
+			{
+				Signers: map[string]hellosign.TemplateSignerDetail{
+					"Candidate": {Name: "Mary"},
+				},
+			},
+		},
+		Metadata: map[string]interface{}{
+			"note": strings.Repeat("v", 501),
+		},
+	}
+
+	err = payload.Validate()
+
+	var validationErrors hellosign.ValidationErrors
+	is.True(errors.As(err, &validationErrors))
+
+	fields := []string{}
+	for _, validationError := range validationErrors {
+		fields = append(fields, validationError.Field)
+	}
+	is.Equal([]string{
+		"template_ids",
+		"metadata[note]",
+		"signer_list[1][signers][Candidate][email_address]",
+	}, fields)
+}
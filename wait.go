@@ -0,0 +1,101 @@
+package hellosign
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// defaultWaitInitialInterval is default delay before the second poll
+	defaultWaitInitialInterval = 5 * time.Second
+
+	// defaultWaitMaxInterval is default maximum delay between polls
+	defaultWaitMaxInterval = time.Minute
+
+	// defaultWaitMultiplier is default growth of delay after each poll
+	defaultWaitMultiplier = 2
+)
+
+// Clock is a source of time used while waiting, it can be replaced in tests
+type Clock interface {
+	After(d time.Duration) <-chan time.Time
+}
+
+// realClock is a clock based on time package
+type realClock struct{}
+
+// After waits for the duration to elapse and then sends the current time
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// WaitOptions is options for waiting a signature request to finish.
+// Zero value of each option uses its default.
+type WaitOptions struct {
+	// InitialInterval is the delay before the second poll, default 5 seconds
+	InitialInterval time.Duration
+	// MaxInterval is the maximum delay between polls, default 1 minute
+	MaxInterval time.Duration
+	// Multiplier is the growth of delay after each poll, default 2
+	Multiplier float64
+	// OnStatusChange is called when a signer status differs from the previous poll.
+	// On the first poll it is called for every signer with an empty previous status.
+	OnStatusChange func(signature SignatureDetail, previousStatus SignerStatus)
+	// Clock is the source of time, default is the system clock
+	Clock Clock
+}
+
+// Wait will poll a signature request with exponential backoff until it is complete,
+// declined or has an error, then returns the last fetched signature request.
+// It stops with ctx error when ctx is done.
+func (s *SignatureRequestAPI) Wait(ctx context.Context, id string, opts WaitOptions) (SignatureRequest, error) {
+	if opts.InitialInterval <= 0 {
+		opts.InitialInterval = defaultWaitInitialInterval
+	}
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = defaultWaitMaxInterval
+	}
+	if opts.Multiplier < 1 {
+		opts.Multiplier = defaultWaitMultiplier
+	}
+	if opts.Clock == nil {
+		opts.Clock = realClock{}
+	}
+
+	statuses := map[string]SignerStatus{}
+	interval := opts.InitialInterval
+	for {
+		signatureRequest, err := s.Get(ctx, id)
+		if err != nil {
+			return SignatureRequest{}, err
+		}
+
+		detail := signatureRequest.SignatureRequest
+		for _, signature := range detail.Signatures {
+			previousStatus, ok := statuses[signature.SignatureID]
+			if ok && previousStatus == signature.StatusCode {
+				continue
+			}
+
+			statuses[signature.SignatureID] = signature.StatusCode
+			if opts.OnStatusChange != nil {
+				opts.OnStatusChange(signature, previousStatus)
+			}
+		}
+
+		if detail.IsComplete || detail.IsDeclined || detail.HasError {
+			return signatureRequest, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return SignatureRequest{}, ctx.Err()
+		case <-opts.Clock.After(interval):
+		}
+
+		interval = time.Duration(float64(interval) * opts.Multiplier)
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
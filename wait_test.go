@@ -0,0 +1,120 @@
+package hellosign_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	hellosign "github.com/milhamhidayat/go-hellosign-sdk"
+	"github.com/milhamhidayat/go-hellosign-sdk/testdata"
+)
+
+// fakeClock fires immediately and records every requested delay
+type fakeClock struct {
+	delays []time.Duration
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.delays = append(c.delays, d)
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+func TestSignatureRequest_Wait(t *testing.T) {
+	is := is.New(t)
+
+	newSignatureRequest := func(isComplete bool, statuses ...string) []byte {
+		signatureRequest := hellosign.SignatureRequest{
+			SignatureRequest: hellosign.SignatureRequestDetail{
+				SignatureRequestID: "fa5c8a0b0f492d768749333ad6fcc214c111e967",
+				IsComplete:         isComplete,
+			},
+		}
+		for i, status := range statuses {
+			signatureRequest.SignatureRequest.Signatures = append(signatureRequest.SignatureRequest.Signatures, hellosign.SignatureDetail{
+				SignatureID: string(rune('a' + i)),
+				StatusCode:  hellosign.SignerStatus(status),
+			})
+		}
+
+		b, err := json.Marshal(signatureRequest)
+		is.NoErr(err)
+		return b
+	}
+
+	responses := [][]byte{
+		newSignatureRequest(false, "awaiting_signature", "awaiting_signature"),
+		newSignatureRequest(false, "awaiting_signature", "awaiting_signature"),
+		newSignatureRequest(false, "signed", "awaiting_signature"),
+		newSignatureRequest(false, "signed", "awaiting_signature"),
+		newSignatureRequest(true, "signed", "signed"),
+	}
+
+	calls := 0
+	mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+		resp := responses[calls]
+		calls++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(resp)),
+			Header:     make(http.Header),
+		}
+	})
+
+	apiClient := hellosign.NewClient("123")
+	apiClient.HTTPClient = mockHTTPClient
+
+	changes := []string{}
+	clock := &fakeClock{}
+	resp, err := apiClient.SignatureRequestAPI.Wait(context.TODO(), "fa5c8a0b0f492d768749333ad6fcc214c111e967", hellosign.WaitOptions{
+		InitialInterval: time.Second,
+		MaxInterval:     5 * time.Second,
+		OnStatusChange: func(signature hellosign.SignatureDetail, previousStatus hellosign.SignerStatus) {
+			changes = append(changes, signature.SignatureID+":"+string(previousStatus)+"->"+string(signature.StatusCode))
+		},
+		Clock: clock,
+	})
+	is.NoErr(err)
+	is.True(resp.SignatureRequest.IsComplete)
+	is.Equal(5, calls)
+	is.Equal([]time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}, clock.delays)
+	is.Equal([]string{
+		"a:->awaiting_signature",
+		"b:->awaiting_signature",
+		"a:awaiting_signature->signed",
+		"b:awaiting_signature->signed",
+	}, changes)
+}
+
+func TestSignatureRequest_WaitContextCanceled(t *testing.T) {
+	is := is.New(t)
+
+	signatureRequestJSON, err := json.Marshal(hellosign.SignatureRequest{})
+	is.NoErr(err)
+
+	mockHTTPClient := testdata.NewClient(t, func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(signatureRequestJSON)),
+			Header:     make(http.Header),
+		}
+	})
+
+	apiClient := hellosign.NewClient("123")
+	apiClient.HTTPClient = mockHTTPClient
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = apiClient.SignatureRequestAPI.Wait(ctx, "fa5c8a0b0f492d768749333ad6fcc214c111e967", hellosign.WaitOptions{
+		InitialInterval: time.Hour,
+	})
+	is.Equal(context.DeadlineExceeded, err)
+}